@@ -1,12 +1,40 @@
 package currency
 
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// The maximum number of decimal digits of a currency.
+const maxDecimalDigits = 18
+
+var (
+	ErrInvalidCurrency = errors.New("i18n: invalid currency")
+
+	// mu guards currencies, which may be changed by Register while other
+	// goroutines look up currencies.
+	mu sync.RWMutex
+)
+
 // Currency represets all details about a currency.
 type Currency struct {
 	Code string
 	// Symbol is the symbol used in the locale, e.g. € for Euro.
 	Symbol string
+	// NarrowSymbol is the shortest symbol of the currency, which may be
+	// shared by other currencies, e.g. $ for US Dollar and Canadian Dollar.
+	NarrowSymbol string
+	// FullSymbol is the symbol that distinguishes the currency from
+	// others with the same narrow symbol, e.g. US$ for US Dollar.
+	FullSymbol string
 	// DecimalDigits is the number of digits after the decimal point.
 	DecimalDigits int
+	// RoundingIncrement is the smallest amount in minor units used in cash
+	// payments, e.g. 5 for Swiss Franc, which has no 1 and 2 rappen coins.
+	// Zero means the minor unit itself, as does 1.
+	RoundingIncrement int
 	// DecimalSeparator is the string used to separate the currency value.
 	DecimalSeparator string
 	// GroupSizes is the size to be used for grouping a currency value,
@@ -18,12 +46,132 @@ type Currency struct {
 	PositivePattern string
 	// NegativePattern is the pattern used for negative currency values.
 	NegativePattern string
+	// Name is the English display name of the currency, e.g. US Dollar.
+	Name string
+	// SingularName is the English name of one unit, e.g. US dollar.
+	SingularName string
+	// PluralName is the English name of several units, e.g. US dollars.
+	PluralName string
+	// MinorUnitName is the English name of one minor unit, e.g. cent.
+	MinorUnitName string
+	// MinorUnitPluralName is the English name of several minor units,
+	// e.g. cents.
+	MinorUnitPluralName string
 }
 
+// Returns the currency with the ISO 4217 code, or nil if there is no
+// such currency. The code may be in any case and have surrounding
+// whitespace, e.g. " usd " returns USD.
 func Get(code string) *Currency {
-	return currencies[code]
+	mu.RLock()
+	defer mu.RUnlock()
+	return currencies[normalize(code)]
+}
+
+// Returns the code in upper case without surrounding whitespace.
+func normalize(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
 }
 
+// Registers a custom currency, e.g. an internal points currency or a
+// crypto currency, or overrides the definition of a known one, so that
+// Get returns it. The code is normalized like in Get and must have 3 to 8
+// letters or digits, and the currency must have between 0 and 18
+// decimal digits and no negative rounding increment, otherwise
+// ErrInvalidCurrency is returned. A copy of c
+// is registered, so c can be modified afterwards without affecting Get.
+func Register(c *Currency) error {
+	code := normalize(c.Code)
+	if len(code) < 3 || len(code) > 8 || c.DecimalDigits < 0 || c.DecimalDigits > maxDecimalDigits || c.RoundingIncrement < 0 {
+		return ErrInvalidCurrency
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return ErrInvalidCurrency
+		}
+	}
+	r := *c
+	r.Code = code
+	r.GroupSizes = append([]int(nil), c.GroupSizes...)
+
+	mu.Lock()
+	currencies[code] = &r
+	mu.Unlock()
+	return nil
+}
+
+// Returns the English name of count units of the currency, e.g.
+// "US dollar" for 1 and "US dollars" for any other count.
+func (c *Currency) UnitName(count int64) string {
+	if count == 1 {
+		return c.SingularName
+	}
+	return c.PluralName
+}
+
+// Returns the English name of count minor units of the currency, e.g.
+// "cent" for 1 and "cents" for any other count.
+func (c *Currency) MinorUnitNameFor(count int64) string {
+	if count == 1 {
+		return c.MinorUnitName
+	}
+	return c.MinorUnitPluralName
+}
+
+// Returns all currencies that use the given symbol, sorted by code, or
+// nil if no currency does. A symbol can be ambiguous, e.g. "$" is used
+// by USD, CAD, AUD and others, so callers must pick one of them, e.g.
+// with the help of a locale. The currencies are copies like in List.
+func GetBySymbol(symbol string) []*Currency {
+	mu.RLock()
+	defer mu.RUnlock()
+	var list []*Currency
+	for _, code := range codes() {
+		if c := currencies[code]; c.Symbol == symbol {
+			list = append(list, c.copy())
+		}
+	}
+	return list
+}
+
+// Returns the map of all currencies by code. It must not be used
+// concurrently with Register.
 func Currencies() map[string]*Currency {
 	return currencies
 }
+
+// Returns all currencies sorted by code. The slice and the currencies in
+// it are copies, so they can be modified without affecting Get.
+func List() []*Currency {
+	mu.RLock()
+	defer mu.RUnlock()
+	list := make([]*Currency, 0, len(currencies))
+	for _, code := range codes() {
+		list = append(list, currencies[code].copy())
+	}
+	return list
+}
+
+// Returns a copy of c that shares no memory with it.
+func (c *Currency) copy() *Currency {
+	r := *c
+	r.GroupSizes = append([]int(nil), c.GroupSizes...)
+	return &r
+}
+
+// Returns the codes of all currencies, sorted.
+func Codes() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return codes()
+}
+
+// Returns the codes of all currencies, sorted. The caller must hold mu.
+func codes() []string {
+	codes := make([]string, 0, len(currencies))
+	for code := range currencies {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
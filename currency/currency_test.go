@@ -31,3 +31,309 @@ func TestCurrencies(t *testing.T) {
 		}
 	}
 }
+
+func TestList(t *testing.T) {
+	list := List()
+	if len(list) != len(currencies) {
+		t.Fatalf("expected %d currencies, got %d", len(currencies), len(list))
+	}
+	for i, c := range list {
+		if i > 0 && list[i-1].Code >= c.Code {
+			t.Errorf("%d. expected %v to be sorted after %v", i, c.Code, list[i-1].Code)
+		}
+		if orig := Get(c.Code); orig == nil || orig.Symbol != c.Symbol {
+			t.Errorf("%d. expected %v to be a copy of Get", i, c.Code)
+		}
+	}
+
+	list[0].Symbol = "changed"
+	list[0].GroupSizes[0] = 42
+	if orig := Get(list[0].Code); orig.Symbol == "changed" || orig.GroupSizes[0] == 42 {
+		t.Errorf("expected currency to be unchanged, got %v", orig)
+	}
+}
+
+func TestCodes(t *testing.T) {
+	codes := Codes()
+	if len(codes) != len(currencies) {
+		t.Fatalf("expected %d codes, got %d", len(currencies), len(codes))
+	}
+	for i, code := range codes {
+		if i > 0 && codes[i-1] >= code {
+			t.Errorf("%d. expected %v to be sorted after %v", i, code, codes[i-1])
+		}
+		if Get(code) == nil {
+			t.Errorf("%d. expected currency %v to be found", i, code)
+		}
+	}
+}
+
+func TestGetBySymbol(t *testing.T) {
+	var tests = []struct {
+		symbol   string
+		expected []string
+	}{
+		/* 0 */ {"€", []string{"EUR"}},
+		/* 1 */ {"£", []string{"GBP"}},
+		/* 2 */ {"$", []string{"ARS", "AUD", "BND", "CAD", "CLP", "COP", "MXN", "NZD", "SGD", "USD"}},
+		/* 3 */ {"XYZ", nil},
+		/* 4 */ {"", nil},
+	}
+
+	for i, f := range tests {
+		list := GetBySymbol(f.symbol)
+		if len(list) != len(f.expected) {
+			t.Fatalf("%d. expected %d currencies, got %d", i, len(f.expected), len(list))
+		}
+		for j, c := range list {
+			if c.Code != f.expected[j] {
+				t.Errorf("%d. expected currency %d to be %v, got %v", i, j, f.expected[j], c.Code)
+			}
+		}
+	}
+
+	c := GetBySymbol("€")[0]
+	c.Symbol = "changed"
+	c.GroupSizes[0] = 42
+	if orig := Get("EUR"); orig.Symbol != "€" || orig.GroupSizes[0] == 42 {
+		t.Errorf("expected currency to be unchanged, got %v", orig)
+	}
+}
+
+func TestNames(t *testing.T) {
+	var tests = []struct {
+		code            string
+		name            string
+		one             string
+		other           string
+		minorUnit       string
+		minorUnitPlural string
+	}{
+		/* 0 */ {"USD", "US Dollar", "US dollar", "US dollars", "cent", "cents"},
+		/* 1 */ {"EUR", "Euro", "euro", "euros", "cent", "cents"},
+		/* 2 */ {"GBP", "British Pound", "British pound", "British pounds", "penny", "pence"},
+		/* 3 */ {"JPY", "Japanese Yen", "Japanese yen", "Japanese yen", "sen", "sen"},
+		/* 4 */ {"PLN", "Polish Zloty", "Polish zloty", "Polish zlotys", "grosz", "groszy"},
+	}
+
+	for i, f := range tests {
+		c := Get(f.code)
+		if c.Name != f.name {
+			t.Errorf("%d. expected Name to be %v, got %v", i, f.name, c.Name)
+		}
+		if got := c.UnitName(1); got != f.one {
+			t.Errorf("%d. expected UnitName(1) to be %v, got %v", i, f.one, got)
+		}
+		if got := c.UnitName(2); got != f.other {
+			t.Errorf("%d. expected UnitName(2) to be %v, got %v", i, f.other, got)
+		}
+		if got := c.MinorUnitNameFor(1); got != f.minorUnit {
+			t.Errorf("%d. expected MinorUnitNameFor(1) to be %v, got %v", i, f.minorUnit, got)
+		}
+		if got := c.MinorUnitNameFor(0); got != f.minorUnitPlural {
+			t.Errorf("%d. expected MinorUnitNameFor(0) to be %v, got %v", i, f.minorUnitPlural, got)
+		}
+	}
+
+	for _, c := range List() {
+		if c.Name == "" || c.SingularName == "" || c.PluralName == "" || c.MinorUnitName == "" || c.MinorUnitPluralName == "" {
+			t.Errorf("expected currency %v to have names", c.Code)
+		}
+	}
+}
+
+func TestDetails(t *testing.T) {
+	// Details of currencies missing in data.go would be dropped silently
+	for code := range details {
+		if Get(code) == nil {
+			t.Errorf("expected details of %v to have a currency", code)
+		}
+	}
+}
+
+func TestDisplayName(t *testing.T) {
+	var tests = []struct {
+		code     string
+		lang     string
+		count    int64
+		expected string
+	}{
+		/*  0 */ {"USD", "en", 0, "US dollars"},
+		/*  1 */ {"USD", "en", 1, "US dollar"},
+		/*  2 */ {"USD", "en_US", 2, "US dollars"},
+		/*  3 */ {"USD", "en", 5, "US dollars"},
+		/*  4 */ {"PLN", "pl", 0, "złotych polskich"},
+		/*  5 */ {"PLN", "pl", 1, "złoty polski"},
+		/*  6 */ {"PLN", "pl_PL", 2, "złote polskie"},
+		/*  7 */ {"PLN", "pl", 5, "złotych polskich"},
+		/*  8 */ {"PLN", "pl", 22, "złote polskie"},
+		/*  9 */ {"USD", "pl", 2, "dolary amerykańskie"},
+		/* 10 */ {"EUR", "pl", 5, "euro"},
+		/* 11 */ {"JPY", "pl", 5, "Japanese yen"},
+		/* 12 */ {"PLN", "xy", 5, "Polish zlotys"},
+	}
+
+	for i, f := range tests {
+		if got := Get(f.code).DisplayName(f.count, f.lang); got != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, got)
+		}
+	}
+}
+
+func TestDisplayNameFraction(t *testing.T) {
+	var tests = []struct {
+		code     string
+		lang     string
+		expected string
+	}{
+		/* 0 */ {"USD", "en", "US dollars"},
+		/* 1 */ {"PLN", "pl_PL", "złotego polskiego"},
+		/* 2 */ {"USD", "pl", "dolara amerykańskiego"},
+		/* 3 */ {"JPY", "pl", "Japanese yen"},
+	}
+
+	for i, f := range tests {
+		if got := Get(f.code).DisplayNameFraction(f.lang); got != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, got)
+		}
+	}
+}
+
+func TestGetNormalizesCode(t *testing.T) {
+	var tests = []struct {
+		codes        []string
+		expectedCode string
+	}{
+		/* 0 */ {[]string{"USD", "usd", "Usd", "uSd", " USD ", "\tusd\n"}, "USD"},
+		/* 1 */ {[]string{"EUR", "eur", " Eur"}, "EUR"},
+	}
+
+	for i, f := range tests {
+		expected := currencies[f.expectedCode]
+		for _, code := range f.codes {
+			if c := Get(code); c != expected {
+				t.Errorf("%d. expected %q to return %v, got %v", i, code, f.expectedCode, c)
+			}
+		}
+	}
+
+	for i, code := range []string{"", "US D", "XYZ", "usdd"} {
+		if c := Get(code); c != nil {
+			t.Errorf("%d. expected %q to return nil, got %v", i, code, c.Code)
+		}
+	}
+}
+
+func TestSymbols(t *testing.T) {
+	var tests = []struct {
+		code   string
+		symbol string
+		narrow string
+		full   string
+	}{
+		/* 0 */ {"USD", "$", "$", "US$"},
+		/* 1 */ {"CAD", "$", "$", "CA$"},
+		/* 2 */ {"HKD", "HK$", "$", "HK$"},
+		/* 3 */ {"JPY", "¥", "¥", "JP¥"},
+		/* 4 */ {"EUR", "€", "€", "€"},
+		/* 5 */ {"CHF", "fr.", "fr.", "fr."},
+	}
+
+	for i, f := range tests {
+		c := Get(f.code)
+		if c.Symbol != f.symbol || c.NarrowSymbol != f.narrow || c.FullSymbol != f.full {
+			t.Errorf("%d. expected symbols %v, %v and %v, got %v, %v and %v", i, f.symbol, f.narrow, f.full, c.Symbol, c.NarrowSymbol, c.FullSymbol)
+		}
+	}
+
+	for _, c := range List() {
+		if c.NarrowSymbol == "" || c.FullSymbol == "" {
+			t.Errorf("expected currency %v to have all symbols", c.Code)
+		}
+	}
+}
+
+func TestRoundingIncrement(t *testing.T) {
+	var tests = []struct {
+		code     string
+		expected int
+	}{
+		/* 0 */ {"CHF", 5},
+		/* 1 */ {"CAD", 5},
+		/* 2 */ {"DKK", 50},
+		/* 3 */ {"SEK", 100},
+		/* 4 */ {"USD", 0},
+		/* 5 */ {"JPY", 0},
+	}
+
+	for i, f := range tests {
+		if c := Get(f.code); c.RoundingIncrement != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, c.RoundingIncrement)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	defer func() {
+		mu.Lock()
+		delete(currencies, "PTS")
+		delete(currencies, "BTC")
+		mu.Unlock()
+	}()
+
+	var tests = []struct {
+		c   *Currency
+		err error
+	}{
+		/* 0 */ {&Currency{Code: "PTS", Symbol: "pts", DecimalDigits: 0, Name: "Points"}, nil},
+		/* 1 */ {&Currency{Code: " btc", Symbol: "₿", DecimalDigits: 8, Name: "Bitcoin"}, nil},
+		/* 2 */ {&Currency{Code: "P", Symbol: "p"}, ErrInvalidCurrency},
+		/* 3 */ {&Currency{Code: "TOOLONGCODE", Symbol: "t"}, ErrInvalidCurrency},
+		/* 4 */ {&Currency{Code: "P-S", Symbol: "p"}, ErrInvalidCurrency},
+		/* 5 */ {&Currency{Code: "NEG", DecimalDigits: -1}, ErrInvalidCurrency},
+		/* 6 */ {&Currency{Code: "BIG", DecimalDigits: 19}, ErrInvalidCurrency},
+		/* 7 */ {&Currency{Code: "INC", DecimalDigits: 2, RoundingIncrement: -5}, ErrInvalidCurrency},
+	}
+
+	for i, f := range tests {
+		if err := Register(f.c); err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+	}
+
+	if c := Get("pts"); c == nil || c.Code != "PTS" || c.Symbol != "pts" || c.DecimalDigits != 0 {
+		t.Errorf("expected PTS to be registered, got %v", c)
+	}
+	if c := Get("BTC"); c == nil || c.Code != "BTC" || c.DecimalDigits != 8 || c.Name != "Bitcoin" {
+		t.Errorf("expected BTC to be registered, got %v", c)
+	}
+	if c := Get("P"); c != nil {
+		t.Errorf("expected P not to be registered, got %v", c)
+	}
+
+	// Registered currencies are copies
+	c := &Currency{Code: "PTS", Symbol: "pts"}
+	Register(c)
+	c.Symbol = "changed"
+	if Get("PTS").Symbol != "pts" {
+		t.Errorf("expected symbol %v, got %v", "pts", Get("PTS").Symbol)
+	}
+}
+
+func TestRegisterOverride(t *testing.T) {
+	orig := currencies["EUR"]
+	defer func() {
+		mu.Lock()
+		currencies["EUR"] = orig
+		mu.Unlock()
+	}()
+
+	c := *orig
+	c.Symbol = "EUR"
+	if err := Register(&c); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if Get("EUR").Symbol != "EUR" {
+		t.Errorf("expected symbol %v, got %v", "EUR", Get("EUR").Symbol)
+	}
+}
@@ -0,0 +1,957 @@
+package currency
+
+// The details of currencies that are not derived from the locale data
+// in data.go, which is generated by generator.Currency, by code. They
+// are merged into the currencies by init.
+var details = map[string]Currency{
+	"AED": {
+		NarrowSymbol:        "د.إ.‏",
+		FullSymbol:          "د.إ.‏",
+		Name:                "UAE Dirham",
+		SingularName:        "UAE dirham",
+		PluralName:          "UAE dirhams",
+		MinorUnitName:       "fils",
+		MinorUnitPluralName: "fils",
+	},
+	"AFN": {
+		NarrowSymbol:        "؋",
+		FullSymbol:          "؋",
+		Name:                "Afghan Afghani",
+		SingularName:        "Afghan Afghani",
+		PluralName:          "Afghan Afghanis",
+		MinorUnitName:       "pul",
+		MinorUnitPluralName: "puls",
+	},
+	"ALL": {
+		NarrowSymbol:        "Lek",
+		FullSymbol:          "Lek",
+		Name:                "Albanian Lek",
+		SingularName:        "Albanian lek",
+		PluralName:          "Albanian lekë",
+		MinorUnitName:       "qindarka",
+		MinorUnitPluralName: "qindarka",
+	},
+	"AMD": {
+		NarrowSymbol:        "֏",
+		FullSymbol:          "դր.",
+		Name:                "Armenian Dram",
+		SingularName:        "Armenian dram",
+		PluralName:          "Armenian drams",
+		MinorUnitName:       "luma",
+		MinorUnitPluralName: "luma",
+	},
+	"ARS": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "ARS",
+		Name:                "Argentine Peso",
+		SingularName:        "Argentine peso",
+		PluralName:          "Argentine pesos",
+		MinorUnitName:       "centavo",
+		MinorUnitPluralName: "centavos",
+	},
+	"AUD": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "A$",
+		Name:                "Australian Dollar",
+		SingularName:        "Australian dollar",
+		PluralName:          "Australian dollars",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"AZN": {
+		NarrowSymbol:        "₼",
+		FullSymbol:          "ман.",
+		Name:                "Azerbaijani Manat",
+		SingularName:        "Azerbaijani manat",
+		PluralName:          "Azerbaijani manats",
+		MinorUnitName:       "qəpik",
+		MinorUnitPluralName: "qəpik",
+	},
+	"BAM": {
+		NarrowSymbol:        "KM",
+		FullSymbol:          "KM",
+		Name:                "Bosnia-Herzegovina Convertible Mark",
+		SingularName:        "Bosnia-Herzegovina convertible mark",
+		PluralName:          "Bosnia-Herzegovina convertible marks",
+		MinorUnitName:       "fening",
+		MinorUnitPluralName: "fenings",
+	},
+	"BDT": {
+		NarrowSymbol:        "৳",
+		FullSymbol:          "৳",
+		Name:                "Bangladeshi Taka",
+		SingularName:        "Bangladeshi taka",
+		PluralName:          "Bangladeshi takas",
+		MinorUnitName:       "poisha",
+		MinorUnitPluralName: "poisha",
+	},
+	"BGN": {
+		NarrowSymbol:        "лв.",
+		FullSymbol:          "лв.",
+		Name:                "Bulgarian Lev",
+		SingularName:        "Bulgarian lev",
+		PluralName:          "Bulgarian leva",
+		MinorUnitName:       "stotinka",
+		MinorUnitPluralName: "stotinki",
+	},
+	"BHD": {
+		NarrowSymbol:        "د.ب.‏",
+		FullSymbol:          "د.ب.‏",
+		Name:                "Bahraini Dinar",
+		SingularName:        "Bahraini dinar",
+		PluralName:          "Bahraini dinars",
+		MinorUnitName:       "fils",
+		MinorUnitPluralName: "fils",
+	},
+	"BND": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "BND",
+		Name:                "Brunei Dollar",
+		SingularName:        "Brunei dollar",
+		PluralName:          "Brunei dollars",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"BOB": {
+		NarrowSymbol:        "Bs",
+		FullSymbol:          "$b",
+		Name:                "Bolivian Boliviano",
+		SingularName:        "Bolivian boliviano",
+		PluralName:          "Bolivian bolivianos",
+		MinorUnitName:       "centavo",
+		MinorUnitPluralName: "centavos",
+	},
+	"BRL": {
+		NarrowSymbol:        "R$",
+		FullSymbol:          "R$",
+		Name:                "Brazilian Real",
+		SingularName:        "Brazilian real",
+		PluralName:          "Brazilian reals",
+		MinorUnitName:       "centavo",
+		MinorUnitPluralName: "centavos",
+	},
+	"BYR": {
+		NarrowSymbol:        "р.",
+		FullSymbol:          "р.",
+		Name:                "Belarusian Ruble",
+		SingularName:        "Belarusian ruble",
+		PluralName:          "Belarusian rubles",
+		MinorUnitName:       "kopek",
+		MinorUnitPluralName: "kopeks",
+	},
+	"BZD": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "BZ$",
+		Name:                "Belize Dollar",
+		SingularName:        "Belize dollar",
+		PluralName:          "Belize dollars",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"CAD": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "CA$",
+		RoundingIncrement:   5,
+		Name:                "Canadian Dollar",
+		SingularName:        "Canadian dollar",
+		PluralName:          "Canadian dollars",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"CHF": {
+		NarrowSymbol:        "fr.",
+		FullSymbol:          "fr.",
+		RoundingIncrement:   5,
+		Name:                "Swiss Franc",
+		SingularName:        "Swiss franc",
+		PluralName:          "Swiss francs",
+		MinorUnitName:       "rappen",
+		MinorUnitPluralName: "rappen",
+	},
+	"CLP": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "CLP",
+		Name:                "Chilean Peso",
+		SingularName:        "Chilean peso",
+		PluralName:          "Chilean pesos",
+		MinorUnitName:       "centavo",
+		MinorUnitPluralName: "centavos",
+	},
+	"CNY": {
+		NarrowSymbol:        "¥",
+		FullSymbol:          "CN¥",
+		Name:                "Chinese Yuan",
+		SingularName:        "Chinese yuan",
+		PluralName:          "Chinese yuan",
+		MinorUnitName:       "fen",
+		MinorUnitPluralName: "fen",
+	},
+	"COP": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "COP",
+		Name:                "Colombian Peso",
+		SingularName:        "Colombian peso",
+		PluralName:          "Colombian pesos",
+		MinorUnitName:       "centavo",
+		MinorUnitPluralName: "centavos",
+	},
+	"CRC": {
+		NarrowSymbol:        "₡",
+		FullSymbol:          "₡",
+		Name:                "Costa Rican Colón",
+		SingularName:        "Costa Rican colón",
+		PluralName:          "Costa Rican colóns",
+		MinorUnitName:       "céntimo",
+		MinorUnitPluralName: "céntimos",
+	},
+	"CSD": {
+		NarrowSymbol:        "Дин.",
+		FullSymbol:          "Дин.",
+		Name:                "Serbian Dinar (2002–2006)",
+		SingularName:        "Serbian dinar (2002–2006)",
+		PluralName:          "Serbian dinars (2002–2006)",
+		MinorUnitName:       "para",
+		MinorUnitPluralName: "para",
+	},
+	"CZK": {
+		NarrowSymbol:        "Kč",
+		FullSymbol:          "Kč",
+		RoundingIncrement:   100,
+		Name:                "Czech Koruna",
+		SingularName:        "Czech koruna",
+		PluralName:          "Czech korunas",
+		MinorUnitName:       "haler",
+		MinorUnitPluralName: "halers",
+	},
+	"DKK": {
+		NarrowSymbol:        "kr",
+		FullSymbol:          "kr.",
+		RoundingIncrement:   50,
+		Name:                "Danish Krone",
+		SingularName:        "Danish krone",
+		PluralName:          "Danish kroner",
+		MinorUnitName:       "øre",
+		MinorUnitPluralName: "øre",
+	},
+	"DOP": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "RD$",
+		Name:                "Dominican Peso",
+		SingularName:        "Dominican peso",
+		PluralName:          "Dominican pesos",
+		MinorUnitName:       "centavo",
+		MinorUnitPluralName: "centavos",
+	},
+	"DZD": {
+		NarrowSymbol:        "د.ج.‏",
+		FullSymbol:          "د.ج.‏",
+		Name:                "Algerian Dinar",
+		SingularName:        "Algerian dinar",
+		PluralName:          "Algerian dinars",
+		MinorUnitName:       "santeem",
+		MinorUnitPluralName: "santeems",
+	},
+	"EEK": {
+		NarrowSymbol:        "kr",
+		FullSymbol:          "kr",
+		Name:                "Estonian Kroon",
+		SingularName:        "Estonian kroon",
+		PluralName:          "Estonian kroons",
+		MinorUnitName:       "sent",
+		MinorUnitPluralName: "senti",
+	},
+	"EGP": {
+		NarrowSymbol:        "ج.م.‏",
+		FullSymbol:          "ج.م.‏",
+		Name:                "Egyptian Pound",
+		SingularName:        "Egyptian pound",
+		PluralName:          "Egyptian pounds",
+		MinorUnitName:       "piastre",
+		MinorUnitPluralName: "piastres",
+	},
+	"ETB": {
+		NarrowSymbol:        "ETB",
+		FullSymbol:          "ETB",
+		Name:                "Ethiopian Birr",
+		SingularName:        "Ethiopian birr",
+		PluralName:          "Ethiopian birrs",
+		MinorUnitName:       "santim",
+		MinorUnitPluralName: "santims",
+	},
+	"EUR": {
+		NarrowSymbol:        "€",
+		FullSymbol:          "€",
+		Name:                "Euro",
+		SingularName:        "euro",
+		PluralName:          "euros",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"GBP": {
+		NarrowSymbol:        "£",
+		FullSymbol:          "£",
+		Name:                "British Pound",
+		SingularName:        "British pound",
+		PluralName:          "British pounds",
+		MinorUnitName:       "penny",
+		MinorUnitPluralName: "pence",
+	},
+	"GEL": {
+		NarrowSymbol:        "₾",
+		FullSymbol:          "Lari",
+		Name:                "Georgian Lari",
+		SingularName:        "Georgian lari",
+		PluralName:          "Georgian laris",
+		MinorUnitName:       "tetri",
+		MinorUnitPluralName: "tetri",
+	},
+	"GTQ": {
+		NarrowSymbol:        "Q",
+		FullSymbol:          "Q",
+		Name:                "Guatemalan Quetzal",
+		SingularName:        "Guatemalan quetzal",
+		PluralName:          "Guatemalan quetzals",
+		MinorUnitName:       "centavo",
+		MinorUnitPluralName: "centavos",
+	},
+	"HKD": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "HK$",
+		Name:                "Hong Kong Dollar",
+		SingularName:        "Hong Kong dollar",
+		PluralName:          "Hong Kong dollars",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"HNL": {
+		NarrowSymbol:        "L.",
+		FullSymbol:          "L.",
+		Name:                "Honduran Lempira",
+		SingularName:        "Honduran lempira",
+		PluralName:          "Honduran lempiras",
+		MinorUnitName:       "centavo",
+		MinorUnitPluralName: "centavos",
+	},
+	"HRK": {
+		NarrowSymbol:        "kn",
+		FullSymbol:          "kn",
+		Name:                "Croatian Kuna",
+		SingularName:        "Croatian kuna",
+		PluralName:          "Croatian kunas",
+		MinorUnitName:       "lipa",
+		MinorUnitPluralName: "lipa",
+	},
+	"HUF": {
+		NarrowSymbol:        "Ft",
+		FullSymbol:          "Ft",
+		Name:                "Hungarian Forint",
+		SingularName:        "Hungarian forint",
+		PluralName:          "Hungarian forints",
+		MinorUnitName:       "fillér",
+		MinorUnitPluralName: "fillér",
+	},
+	"IDR": {
+		NarrowSymbol:        "Rp",
+		FullSymbol:          "Rp",
+		Name:                "Indonesian Rupiah",
+		SingularName:        "Indonesian rupiah",
+		PluralName:          "Indonesian rupiahs",
+		MinorUnitName:       "sen",
+		MinorUnitPluralName: "sen",
+	},
+	"ILS": {
+		NarrowSymbol:        "₪",
+		FullSymbol:          "₪",
+		Name:                "Israeli New Shekel",
+		SingularName:        "Israeli new shekel",
+		PluralName:          "Israeli new shekels",
+		MinorUnitName:       "agora",
+		MinorUnitPluralName: "agorot",
+	},
+	"INR": {
+		NarrowSymbol:        "₹",
+		FullSymbol:          "₹",
+		Name:                "Indian Rupee",
+		SingularName:        "Indian rupee",
+		PluralName:          "Indian rupees",
+		MinorUnitName:       "paisa",
+		MinorUnitPluralName: "paise",
+	},
+	"IQD": {
+		NarrowSymbol:        "د.ع.‏",
+		FullSymbol:          "د.ع.‏",
+		Name:                "Iraqi Dinar",
+		SingularName:        "Iraqi dinar",
+		PluralName:          "Iraqi dinars",
+		MinorUnitName:       "fils",
+		MinorUnitPluralName: "fils",
+	},
+	"IRR": {
+		NarrowSymbol:        "ريال",
+		FullSymbol:          "ريال",
+		Name:                "Iranian Rial",
+		SingularName:        "Iranian rial",
+		PluralName:          "Iranian rials",
+		MinorUnitName:       "dinar",
+		MinorUnitPluralName: "dinars",
+	},
+	"ISK": {
+		NarrowSymbol:        "kr",
+		FullSymbol:          "kr.",
+		Name:                "Icelandic Króna",
+		SingularName:        "Icelandic króna",
+		PluralName:          "Icelandic krónur",
+		MinorUnitName:       "eyrir",
+		MinorUnitPluralName: "aurar",
+	},
+	"JMD": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "J$",
+		Name:                "Jamaican Dollar",
+		SingularName:        "Jamaican dollar",
+		PluralName:          "Jamaican dollars",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"JOD": {
+		NarrowSymbol:        "د.ا.‏",
+		FullSymbol:          "د.ا.‏",
+		Name:                "Jordanian Dinar",
+		SingularName:        "Jordanian dinar",
+		PluralName:          "Jordanian dinars",
+		MinorUnitName:       "fils",
+		MinorUnitPluralName: "fils",
+	},
+	"JPY": {
+		NarrowSymbol:        "¥",
+		FullSymbol:          "JP¥",
+		Name:                "Japanese Yen",
+		SingularName:        "Japanese yen",
+		PluralName:          "Japanese yen",
+		MinorUnitName:       "sen",
+		MinorUnitPluralName: "sen",
+	},
+	"KES": {
+		NarrowSymbol:        "S",
+		FullSymbol:          "S",
+		Name:                "Kenyan Shilling",
+		SingularName:        "Kenyan shilling",
+		PluralName:          "Kenyan shillings",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"KGS": {
+		NarrowSymbol:        "сом",
+		FullSymbol:          "сом",
+		Name:                "Kyrgystani Som",
+		SingularName:        "Kyrgystani som",
+		PluralName:          "Kyrgystani soms",
+		MinorUnitName:       "tyiyn",
+		MinorUnitPluralName: "tyiyns",
+	},
+	"KHR": {
+		NarrowSymbol:        "៛",
+		FullSymbol:          "៛",
+		Name:                "Cambodian Riel",
+		SingularName:        "Cambodian riel",
+		PluralName:          "Cambodian riels",
+		MinorUnitName:       "sen",
+		MinorUnitPluralName: "sen",
+	},
+	"KRW": {
+		NarrowSymbol:        "₩",
+		FullSymbol:          "₩",
+		Name:                "South Korean Won",
+		SingularName:        "South Korean won",
+		PluralName:          "South Korean won",
+		MinorUnitName:       "jeon",
+		MinorUnitPluralName: "jeon",
+	},
+	"KWD": {
+		NarrowSymbol:        "د.ك.‏",
+		FullSymbol:          "د.ك.‏",
+		Name:                "Kuwaiti Dinar",
+		SingularName:        "Kuwaiti dinar",
+		PluralName:          "Kuwaiti dinars",
+		MinorUnitName:       "fils",
+		MinorUnitPluralName: "fils",
+	},
+	"KZT": {
+		NarrowSymbol:        "₸",
+		FullSymbol:          "Т",
+		Name:                "Kazakhstani Tenge",
+		SingularName:        "Kazakhstani tenge",
+		PluralName:          "Kazakhstani tenges",
+		MinorUnitName:       "tiyn",
+		MinorUnitPluralName: "tiyns",
+	},
+	"LAK": {
+		NarrowSymbol:        "₭",
+		FullSymbol:          "₭",
+		Name:                "Laotian Kip",
+		SingularName:        "Laotian kip",
+		PluralName:          "Laotian kips",
+		MinorUnitName:       "att",
+		MinorUnitPluralName: "att",
+	},
+	"LBP": {
+		NarrowSymbol:        "ل.ل.‏",
+		FullSymbol:          "ل.ل.‏",
+		Name:                "Lebanese Pound",
+		SingularName:        "Lebanese pound",
+		PluralName:          "Lebanese pounds",
+		MinorUnitName:       "piastre",
+		MinorUnitPluralName: "piastres",
+	},
+	"LKR": {
+		NarrowSymbol:        "Rs",
+		FullSymbol:          "රු.",
+		Name:                "Sri Lankan Rupee",
+		SingularName:        "Sri Lankan rupee",
+		PluralName:          "Sri Lankan rupees",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"LTL": {
+		NarrowSymbol:        "Lt",
+		FullSymbol:          "Lt",
+		Name:                "Lithuanian Litas",
+		SingularName:        "Lithuanian litas",
+		PluralName:          "Lithuanian litai",
+		MinorUnitName:       "centas",
+		MinorUnitPluralName: "centai",
+	},
+	"LVL": {
+		NarrowSymbol:        "Ls",
+		FullSymbol:          "Ls",
+		Name:                "Latvian Lats",
+		SingularName:        "Latvian lats",
+		PluralName:          "Latvian lati",
+		MinorUnitName:       "santims",
+		MinorUnitPluralName: "santimi",
+	},
+	"LYD": {
+		NarrowSymbol:        "د.ل.‏",
+		FullSymbol:          "د.ل.‏",
+		Name:                "Libyan Dinar",
+		SingularName:        "Libyan dinar",
+		PluralName:          "Libyan dinars",
+		MinorUnitName:       "dirham",
+		MinorUnitPluralName: "dirhams",
+	},
+	"MAD": {
+		NarrowSymbol:        "د.م.‏",
+		FullSymbol:          "د.م.‏",
+		Name:                "Moroccan Dirham",
+		SingularName:        "Moroccan dirham",
+		PluralName:          "Moroccan dirhams",
+		MinorUnitName:       "santim",
+		MinorUnitPluralName: "santims",
+	},
+	"MKD": {
+		NarrowSymbol:        "ден.",
+		FullSymbol:          "ден.",
+		Name:                "Macedonian Denar",
+		SingularName:        "Macedonian denar",
+		PluralName:          "Macedonian denari",
+		MinorUnitName:       "deni",
+		MinorUnitPluralName: "deni",
+	},
+	"MNT": {
+		NarrowSymbol:        "₮",
+		FullSymbol:          "₮",
+		Name:                "Mongolian Tugrik",
+		SingularName:        "Mongolian tugrik",
+		PluralName:          "Mongolian tugriks",
+		MinorUnitName:       "möngö",
+		MinorUnitPluralName: "möngö",
+	},
+	"MOP": {
+		NarrowSymbol:        "MOP",
+		FullSymbol:          "MOP",
+		Name:                "Macanese Pataca",
+		SingularName:        "Macanese pataca",
+		PluralName:          "Macanese patacas",
+		MinorUnitName:       "avo",
+		MinorUnitPluralName: "avos",
+	},
+	"MVR": {
+		NarrowSymbol:        "ރ.",
+		FullSymbol:          "ރ.",
+		Name:                "Maldivian Rufiyaa",
+		SingularName:        "Maldivian rufiyaa",
+		PluralName:          "Maldivian rufiyaas",
+		MinorUnitName:       "laari",
+		MinorUnitPluralName: "laari",
+	},
+	"MXN": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "MX$",
+		Name:                "Mexican Peso",
+		SingularName:        "Mexican peso",
+		PluralName:          "Mexican pesos",
+		MinorUnitName:       "centavo",
+		MinorUnitPluralName: "centavos",
+	},
+	"MYR": {
+		NarrowSymbol:        "RM",
+		FullSymbol:          "RM",
+		Name:                "Malaysian Ringgit",
+		SingularName:        "Malaysian ringgit",
+		PluralName:          "Malaysian ringgits",
+		MinorUnitName:       "sen",
+		MinorUnitPluralName: "sen",
+	},
+	"NIO": {
+		NarrowSymbol:        "C$",
+		FullSymbol:          "N",
+		Name:                "Nicaraguan Córdoba",
+		SingularName:        "Nicaraguan córdoba",
+		PluralName:          "Nicaraguan córdobas",
+		MinorUnitName:       "centavo",
+		MinorUnitPluralName: "centavos",
+	},
+	"NOK": {
+		NarrowSymbol:        "kr",
+		FullSymbol:          "kr",
+		RoundingIncrement:   100,
+		Name:                "Norwegian Krone",
+		SingularName:        "Norwegian krone",
+		PluralName:          "Norwegian kroner",
+		MinorUnitName:       "øre",
+		MinorUnitPluralName: "øre",
+	},
+	"NPR": {
+		NarrowSymbol:        "Rs",
+		FullSymbol:          "रु",
+		Name:                "Nepalese Rupee",
+		SingularName:        "Nepalese rupee",
+		PluralName:          "Nepalese rupees",
+		MinorUnitName:       "paisa",
+		MinorUnitPluralName: "paise",
+	},
+	"NZD": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "NZ$",
+		Name:                "New Zealand Dollar",
+		SingularName:        "New Zealand dollar",
+		PluralName:          "New Zealand dollars",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"OMR": {
+		NarrowSymbol:        "ر.ع.‏",
+		FullSymbol:          "ر.ع.‏",
+		Name:                "Omani Rial",
+		SingularName:        "Omani rial",
+		PluralName:          "Omani rials",
+		MinorUnitName:       "baisa",
+		MinorUnitPluralName: "baisa",
+	},
+	"PAB": {
+		NarrowSymbol:        "B/.",
+		FullSymbol:          "B/.",
+		Name:                "Panamanian Balboa",
+		SingularName:        "Panamanian balboa",
+		PluralName:          "Panamanian balboas",
+		MinorUnitName:       "centésimo",
+		MinorUnitPluralName: "centésimos",
+	},
+	"PEN": {
+		NarrowSymbol:        "S/.",
+		FullSymbol:          "S/.",
+		Name:                "Peruvian Sol",
+		SingularName:        "Peruvian sol",
+		PluralName:          "Peruvian soles",
+		MinorUnitName:       "céntimo",
+		MinorUnitPluralName: "céntimos",
+	},
+	"PHP": {
+		NarrowSymbol:        "₱",
+		FullSymbol:          "₱",
+		Name:                "Philippine Peso",
+		SingularName:        "Philippine peso",
+		PluralName:          "Philippine pesos",
+		MinorUnitName:       "sentimo",
+		MinorUnitPluralName: "sentimo",
+	},
+	"PKR": {
+		NarrowSymbol:        "Rs",
+		FullSymbol:          "Rs",
+		Name:                "Pakistani Rupee",
+		SingularName:        "Pakistani rupee",
+		PluralName:          "Pakistani rupees",
+		MinorUnitName:       "paisa",
+		MinorUnitPluralName: "paise",
+	},
+	"PLN": {
+		NarrowSymbol:        "zł",
+		FullSymbol:          "zł",
+		Name:                "Polish Zloty",
+		SingularName:        "Polish zloty",
+		PluralName:          "Polish zlotys",
+		MinorUnitName:       "grosz",
+		MinorUnitPluralName: "groszy",
+	},
+	"PYG": {
+		NarrowSymbol:        "₲",
+		FullSymbol:          "Gs",
+		Name:                "Paraguayan Guarani",
+		SingularName:        "Paraguayan guarani",
+		PluralName:          "Paraguayan guaranis",
+		MinorUnitName:       "céntimo",
+		MinorUnitPluralName: "céntimos",
+	},
+	"QAR": {
+		NarrowSymbol:        "ر.ق.‏",
+		FullSymbol:          "ر.ق.‏",
+		Name:                "Qatari Riyal",
+		SingularName:        "Qatari riyal",
+		PluralName:          "Qatari riyals",
+		MinorUnitName:       "dirham",
+		MinorUnitPluralName: "dirhams",
+	},
+	"RON": {
+		NarrowSymbol:        "lei",
+		FullSymbol:          "lei",
+		Name:                "Romanian Leu",
+		SingularName:        "Romanian leu",
+		PluralName:          "Romanian lei",
+		MinorUnitName:       "ban",
+		MinorUnitPluralName: "bani",
+	},
+	"RSD": {
+		NarrowSymbol:        "Din.",
+		FullSymbol:          "Din.",
+		Name:                "Serbian Dinar",
+		SingularName:        "Serbian dinar",
+		PluralName:          "Serbian dinars",
+		MinorUnitName:       "para",
+		MinorUnitPluralName: "para",
+	},
+	"RUB": {
+		NarrowSymbol:        "₽",
+		FullSymbol:          "һ.",
+		Name:                "Russian Ruble",
+		SingularName:        "Russian ruble",
+		PluralName:          "Russian rubles",
+		MinorUnitName:       "kopek",
+		MinorUnitPluralName: "kopeks",
+	},
+	"RWF": {
+		NarrowSymbol:        "RWF",
+		FullSymbol:          "RWF",
+		Name:                "Rwandan Franc",
+		SingularName:        "Rwandan franc",
+		PluralName:          "Rwandan francs",
+		MinorUnitName:       "centime",
+		MinorUnitPluralName: "centimes",
+	},
+	"SAR": {
+		NarrowSymbol:        "ر.س.‏",
+		FullSymbol:          "ر.س.‏",
+		Name:                "Saudi Riyal",
+		SingularName:        "Saudi riyal",
+		PluralName:          "Saudi riyals",
+		MinorUnitName:       "halala",
+		MinorUnitPluralName: "halalas",
+	},
+	"SEK": {
+		NarrowSymbol:        "kr",
+		FullSymbol:          "kr",
+		RoundingIncrement:   100,
+		Name:                "Swedish Krona",
+		SingularName:        "Swedish krona",
+		PluralName:          "Swedish kronor",
+		MinorUnitName:       "öre",
+		MinorUnitPluralName: "öre",
+	},
+	"SGD": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "SGD",
+		Name:                "Singapore Dollar",
+		SingularName:        "Singapore dollar",
+		PluralName:          "Singapore dollars",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"SYP": {
+		NarrowSymbol:        "ل.س.‏",
+		FullSymbol:          "ل.س.‏",
+		Name:                "Syrian Pound",
+		SingularName:        "Syrian pound",
+		PluralName:          "Syrian pounds",
+		MinorUnitName:       "piastre",
+		MinorUnitPluralName: "piastres",
+	},
+	"THB": {
+		NarrowSymbol:        "฿",
+		FullSymbol:          "฿",
+		Name:                "Thai Baht",
+		SingularName:        "Thai baht",
+		PluralName:          "Thai baht",
+		MinorUnitName:       "satang",
+		MinorUnitPluralName: "satang",
+	},
+	"TJS": {
+		NarrowSymbol:        "т.р.",
+		FullSymbol:          "т.р.",
+		Name:                "Tajikistani Somoni",
+		SingularName:        "Tajikistani somoni",
+		PluralName:          "Tajikistani somonis",
+		MinorUnitName:       "diram",
+		MinorUnitPluralName: "dirams",
+	},
+	"TMT": {
+		NarrowSymbol:        "m.",
+		FullSymbol:          "m.",
+		Name:                "Turkmenistani Manat",
+		SingularName:        "Turkmenistani manat",
+		PluralName:          "Turkmenistani manat",
+		MinorUnitName:       "tenge",
+		MinorUnitPluralName: "tenge",
+	},
+	"TND": {
+		NarrowSymbol:        "د.ت.‏",
+		FullSymbol:          "د.ت.‏",
+		Name:                "Tunisian Dinar",
+		SingularName:        "Tunisian dinar",
+		PluralName:          "Tunisian dinars",
+		MinorUnitName:       "millime",
+		MinorUnitPluralName: "millimes",
+	},
+	"TRY": {
+		NarrowSymbol:        "₺",
+		FullSymbol:          "TL",
+		Name:                "Turkish Lira",
+		SingularName:        "Turkish lira",
+		PluralName:          "Turkish lira",
+		MinorUnitName:       "kuruş",
+		MinorUnitPluralName: "kuruş",
+	},
+	"TTD": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "TT$",
+		Name:                "Trinidad & Tobago Dollar",
+		SingularName:        "Trinidad & Tobago dollar",
+		PluralName:          "Trinidad & Tobago dollars",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"TWD": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "NT$",
+		Name:                "New Taiwan Dollar",
+		SingularName:        "New Taiwan dollar",
+		PluralName:          "New Taiwan dollars",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"UAH": {
+		NarrowSymbol:        "₴",
+		FullSymbol:          "₴",
+		Name:                "Ukrainian Hryvnia",
+		SingularName:        "Ukrainian hryvnia",
+		PluralName:          "Ukrainian hryvnias",
+		MinorUnitName:       "kopiyka",
+		MinorUnitPluralName: "kopiykas",
+	},
+	"USD": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "US$",
+		Name:                "US Dollar",
+		SingularName:        "US dollar",
+		PluralName:          "US dollars",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"UYU": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "UYU",
+		Name:                "Uruguayan Peso",
+		SingularName:        "Uruguayan peso",
+		PluralName:          "Uruguayan pesos",
+		MinorUnitName:       "centésimo",
+		MinorUnitPluralName: "centésimos",
+	},
+	"UZS": {
+		NarrowSymbol:        "сўм",
+		FullSymbol:          "сўм",
+		Name:                "Uzbekistani Som",
+		SingularName:        "Uzbekistani som",
+		PluralName:          "Uzbekistani som",
+		MinorUnitName:       "tiyin",
+		MinorUnitPluralName: "tiyin",
+	},
+	"VEF": {
+		NarrowSymbol:        "Bs. F.",
+		FullSymbol:          "Bs. F.",
+		Name:                "Venezuelan Bolívar",
+		SingularName:        "Venezuelan bolívar",
+		PluralName:          "Venezuelan bolívars",
+		MinorUnitName:       "céntimo",
+		MinorUnitPluralName: "céntimos",
+	},
+	"VND": {
+		NarrowSymbol:        "₫",
+		FullSymbol:          "₫",
+		Name:                "Vietnamese Dong",
+		SingularName:        "Vietnamese dong",
+		PluralName:          "Vietnamese dong",
+		MinorUnitName:       "hào",
+		MinorUnitPluralName: "hào",
+	},
+	"XOF": {
+		NarrowSymbol:        "XOF",
+		FullSymbol:          "XOF",
+		Name:                "West African CFA Franc",
+		SingularName:        "CFA franc BCEAO",
+		PluralName:          "CFA francs BCEAO",
+		MinorUnitName:       "centime",
+		MinorUnitPluralName: "centimes",
+	},
+	"YER": {
+		NarrowSymbol:        "ر.ي.‏",
+		FullSymbol:          "ر.ي.‏",
+		Name:                "Yemeni Rial",
+		SingularName:        "Yemeni rial",
+		PluralName:          "Yemeni rials",
+		MinorUnitName:       "fils",
+		MinorUnitPluralName: "fils",
+	},
+	"ZAR": {
+		NarrowSymbol:        "R",
+		FullSymbol:          "R",
+		Name:                "South African Rand",
+		SingularName:        "South African rand",
+		PluralName:          "South African rand",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+	"ZWL": {
+		NarrowSymbol:        "$",
+		FullSymbol:          "Z$",
+		Name:                "Zimbabwean Dollar (2009)",
+		SingularName:        "Zimbabwean dollar (2009)",
+		PluralName:          "Zimbabwean dollars (2009)",
+		MinorUnitName:       "cent",
+		MinorUnitPluralName: "cents",
+	},
+}
+
+func init() {
+	for code, d := range details {
+		c, found := currencies[code]
+		if !found {
+			continue
+		}
+		c.NarrowSymbol = d.NarrowSymbol
+		c.FullSymbol = d.FullSymbol
+		c.RoundingIncrement = d.RoundingIncrement
+		c.Name = d.Name
+		c.SingularName = d.SingularName
+		c.PluralName = d.PluralName
+		c.MinorUnitName = d.MinorUnitName
+		c.MinorUnitPluralName = d.MinorUnitPluralName
+	}
+}
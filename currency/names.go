@@ -0,0 +1,78 @@
+package currency
+
+import (
+	"github.com/hailocab/i18n-go/locale"
+	"strings"
+)
+
+// The names of currencies by language, currency code and plural
+// category. English names are those of the Currency.
+var localizedNames = map[string]map[string]map[locale.PluralCategory]string{
+	"pl": {
+		"CHF": {
+			locale.PluralOne:   "frank szwajcarski",
+			locale.PluralFew:   "franki szwajcarskie",
+			locale.PluralMany:  "franków szwajcarskich",
+			locale.PluralOther: "franka szwajcarskiego",
+		},
+		"EUR": {
+			locale.PluralOne:   "euro",
+			locale.PluralFew:   "euro",
+			locale.PluralMany:  "euro",
+			locale.PluralOther: "euro",
+		},
+		"GBP": {
+			locale.PluralOne:   "funt szterling",
+			locale.PluralFew:   "funty szterlingi",
+			locale.PluralMany:  "funtów szterlingów",
+			locale.PluralOther: "funta szterlinga",
+		},
+		"PLN": {
+			locale.PluralOne:   "złoty polski",
+			locale.PluralFew:   "złote polskie",
+			locale.PluralMany:  "złotych polskich",
+			locale.PluralOther: "złotego polskiego",
+		},
+		"USD": {
+			locale.PluralOne:   "dolar amerykański",
+			locale.PluralFew:   "dolary amerykańskie",
+			locale.PluralMany:  "dolarów amerykańskich",
+			locale.PluralOther: "dolara amerykańskiego",
+		},
+	},
+}
+
+// Returns the name of count units of the currency in the language of the
+// tag, using the plural rules of the language (see locale.Plural), e.g.
+// "US dollars" for 2 in "en", and "złote polskie" for 2 and "złotych
+// polskich" for 5 in "pl". The English name is returned if there is no
+// name of the currency in the language.
+func (c *Currency) DisplayName(count int64, tag string) string {
+	lang := language(tag)
+	if names, found := localizedNames[lang][c.Code]; found {
+		if name, found := names[locale.Plural(lang, count)]; found {
+			return name
+		}
+		return names[locale.PluralOther]
+	}
+	return c.UnitName(count)
+}
+
+// Returns the name of a fractional amount of the currency in the language
+// of the tag like DisplayName, e.g. "US dollars" for 1.50 in "en" and
+// "złotego polskiego" in "pl".
+func (c *Currency) DisplayNameFraction(tag string) string {
+	if names, found := localizedNames[language(tag)][c.Code]; found {
+		return names[locale.PluralOther]
+	}
+	return c.PluralName
+}
+
+// Returns the lower case language of the tag, e.g. "pl" for "pl_PL".
+func language(tag string) string {
+	lang := strings.ToLower(tag)
+	if i := strings.IndexAny(lang, "_-"); i >= 0 {
+		lang = lang[:i]
+	}
+	return lang
+}
@@ -8,6 +8,9 @@ import (
 	"sort"
 )
 
+// Currency writes the currencies of the locales as currency/data.go to
+// stdout. Details that are not in the locale data, like the names of a
+// currency, are maintained in currency/details.go instead.
 func Currency() {
 	currencies := make(map[string]*currency.Currency)
 	for _, v := range locale.Locales() {
@@ -14,10 +14,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -39,10 +42,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            "፣",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NAN",
 		NumberDecimalDigits:      1,
@@ -64,10 +70,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      2,
@@ -89,10 +98,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      3,
@@ -114,10 +126,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      2,
@@ -139,10 +154,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      3,
@@ -164,10 +182,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      2,
@@ -189,10 +210,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      3,
@@ -214,10 +238,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      3,
@@ -239,10 +266,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      2,
@@ -264,10 +294,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      3,
@@ -289,10 +322,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      2,
@@ -314,10 +350,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      2,
@@ -339,10 +378,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      2,
@@ -364,10 +406,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      2,
@@ -389,10 +434,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      2,
@@ -414,10 +462,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      3,
@@ -439,10 +490,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ليس برقم",
 		NumberDecimalDigits:      2,
@@ -464,10 +518,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "-$ n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -489,10 +546,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "n$",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "nan",
 		NumberDecimalDigits:      2,
@@ -514,10 +574,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -539,10 +602,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -564,10 +630,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -589,10 +658,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -614,10 +686,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -639,10 +714,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -664,10 +742,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -689,10 +770,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ཨང་ཀི་མིན་པ།",
 		NumberDecimalDigits:      2,
@@ -714,10 +798,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NkN",
 		NumberDecimalDigits:      2,
@@ -739,10 +826,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -764,10 +854,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -789,10 +882,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -814,10 +910,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Mica numericu",
 		NumberDecimalDigits:      2,
@@ -839,10 +938,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Není číslo",
 		NumberDecimalDigits:      2,
@@ -864,10 +966,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -889,10 +994,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -914,10 +1022,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "-$ n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "n. def.",
 		NumberDecimalDigits:      2,
@@ -939,10 +1050,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   "'",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "n. def.",
 		NumberDecimalDigits:      2,
@@ -964,10 +1078,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "n. def.",
 		NumberDecimalDigits:      2,
@@ -989,10 +1106,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   "'",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "n. def.",
 		NumberDecimalDigits:      2,
@@ -1014,10 +1134,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "n. def.",
 		NumberDecimalDigits:      2,
@@ -1039,10 +1162,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "njedefinowane",
 		NumberDecimalDigits:      2,
@@ -1064,10 +1190,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "n $-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            "،",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1089,10 +1218,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "μη αριθμός",
 		NumberDecimalDigits:      2,
@@ -1114,10 +1246,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1139,10 +1274,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1164,10 +1302,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1189,10 +1330,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1214,10 +1358,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1239,10 +1386,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1264,10 +1414,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1289,10 +1442,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1314,10 +1470,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1339,10 +1498,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1364,10 +1526,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1389,10 +1554,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1414,10 +1582,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1439,10 +1610,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1464,10 +1638,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1489,10 +1666,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -1514,10 +1694,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1539,10 +1722,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "($ n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1564,10 +1750,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "-$ n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1589,10 +1778,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "($ n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1614,10 +1806,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1639,10 +1834,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1664,10 +1862,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "($ n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1689,10 +1890,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1714,10 +1918,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1739,10 +1946,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1764,10 +1974,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1789,10 +2002,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "($ n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1814,10 +2030,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "($ n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1839,10 +2058,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1864,10 +2086,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "($ n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1889,10 +2114,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "($ n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1914,10 +2142,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1939,10 +2170,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1964,10 +2198,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "($ n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -1989,10 +2226,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -2014,10 +2254,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "avaldamatu",
 		NumberDecimalDigits:      2,
@@ -2039,10 +2282,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "% n",
+		PercentNegativePattern:   "-% n",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "EdZ",
 		NumberDecimalDigits:      2,
@@ -2064,10 +2310,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2089,10 +2338,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2114,10 +2366,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2139,10 +2394,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2164,10 +2422,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
 		NumberDecimalDigits:      2,
@@ -2189,10 +2450,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "(n $)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
 		NumberDecimalDigits:      2,
@@ -2214,10 +2478,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   "'",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
 		NumberDecimalDigits:      2,
@@ -2239,10 +2506,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
 		NumberDecimalDigits:      2,
@@ -2264,10 +2534,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
 		NumberDecimalDigits:      2,
@@ -2289,10 +2562,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
 		NumberDecimalDigits:      2,
@@ -2314,10 +2590,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2339,10 +2618,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2364,10 +2646,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2389,10 +2674,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -2414,10 +2702,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Ohne Nummer",
 		NumberDecimalDigits:      2,
@@ -2439,10 +2730,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2464,10 +2758,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2489,10 +2786,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "לא מספר",
 		NumberDecimalDigits:      2,
@@ -2514,10 +2814,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2539,10 +2842,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2564,10 +2870,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2589,10 +2898,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "njedefinowane",
 		NumberDecimalDigits:      2,
@@ -2614,10 +2926,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "nem szám",
 		NumberDecimalDigits:      2,
@@ -2639,10 +2954,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2664,10 +2982,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2689,10 +3010,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2714,10 +3038,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ꌗꂷꀋꉬ",
 		NumberDecimalDigits:      2,
@@ -2739,10 +3066,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2764,10 +3094,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   "'",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non un numero reale",
 		NumberDecimalDigits:      2,
@@ -2789,10 +3122,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "-$ n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non un numero reale",
 		NumberDecimalDigits:      2,
@@ -2814,10 +3150,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2839,10 +3178,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2864,10 +3206,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN (非数値)",
 		NumberDecimalDigits:      2,
@@ -2889,10 +3234,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2914,10 +3262,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2939,10 +3290,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -2964,10 +3318,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "n$",
 		CurrencyNegativePattern:  "-n$",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NAN",
 		NumberDecimalDigits:      2,
@@ -2989,10 +3346,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3014,10 +3374,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3039,10 +3402,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3064,10 +3430,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3089,10 +3458,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "n. num.",
 		NumberDecimalDigits:      2,
@@ -3114,10 +3486,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "n$",
 		CurrencyNegativePattern:  "(n$)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3139,10 +3514,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3164,10 +3542,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "-$ n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3189,10 +3570,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3214,10 +3598,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3239,10 +3626,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3264,10 +3654,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n$",
 		CurrencyNegativePattern:  "-n$",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3289,10 +3682,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "ᠲᠤᠭᠠᠠ ᠪᠤᠰᠤ",
 		NumberDecimalDigits:      2,
@@ -3314,10 +3710,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3339,10 +3738,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3364,10 +3766,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3389,10 +3794,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3414,10 +3822,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3439,10 +3850,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3464,10 +3878,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "nan",
 		NumberDecimalDigits:      2,
@@ -3489,10 +3906,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN (Niet-een-getal)",
 		NumberDecimalDigits:      2,
@@ -3514,10 +3934,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3539,10 +3962,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3564,10 +3990,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3589,10 +4018,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numeric",
 		NumberDecimalDigits:      2,
@@ -3614,10 +4046,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3639,10 +4074,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3664,10 +4102,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "nie jest liczbą",
 		NumberDecimalDigits:      2,
@@ -3689,10 +4130,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "غ ع",
 		NumberDecimalDigits:      2,
@@ -3714,10 +4158,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   "٬",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "غ ع",
 		NumberDecimalDigits:      2,
@@ -3739,10 +4186,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "-$ n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN (Não é um número)",
 		NumberDecimalDigits:      2,
@@ -3764,10 +4214,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN (Não é um número)",
 		NumberDecimalDigits:      2,
@@ -3789,10 +4242,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NeuN",
 		NumberDecimalDigits:      2,
@@ -3814,10 +4270,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "($ n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3839,10 +4298,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "($ n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3864,10 +4326,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3889,10 +4354,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   "'",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "betg def.",
 		NumberDecimalDigits:      2,
@@ -3914,10 +4382,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3939,10 +4410,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n$",
 		CurrencyNegativePattern:  "-n$",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3964,10 +4438,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -3989,10 +4466,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4014,10 +4494,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n$",
 		CurrencyNegativePattern:  "-n$",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NAN",
 		NumberDecimalDigits:      2,
@@ -4039,10 +4522,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4064,10 +4550,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4089,10 +4578,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4114,10 +4606,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "($ n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4139,10 +4634,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Nie je číslo",
 		NumberDecimalDigits:      2,
@@ -4164,10 +4662,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4189,10 +4690,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4214,10 +4718,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4239,10 +4746,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4264,10 +4774,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4289,10 +4802,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4314,10 +4830,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4339,10 +4858,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n$",
 		CurrencyNegativePattern:  "-n$",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4364,10 +4886,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4389,10 +4914,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4414,10 +4942,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4439,10 +4970,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4464,10 +4998,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4489,10 +5026,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4514,10 +5054,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4539,10 +5082,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4564,10 +5110,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4589,10 +5138,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "\u2212",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4614,10 +5166,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4639,10 +5194,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4664,10 +5222,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4689,10 +5250,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$ -n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4714,10 +5278,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4739,10 +5306,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4764,10 +5334,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n$",
 		CurrencyNegativePattern:  "-n$",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4789,10 +5362,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4814,10 +5390,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "%n",
+		PercentNegativePattern:   "-%n",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4839,10 +5418,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4864,10 +5446,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
 		NumberDecimalDigits:      2,
@@ -4889,10 +5474,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "سان ئەمەس",
 		NumberDecimalDigits:      2,
@@ -4914,10 +5502,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n$",
 		CurrencyNegativePattern:  "-n$",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n %",
+		PercentNegativePattern:   "-n %",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4939,10 +5530,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "$n-",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4964,10 +5558,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -4989,10 +5586,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -5014,10 +5614,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ".",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -5039,10 +5642,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   " ",
 		CurrencyPositivePattern:  "n $",
 		CurrencyNegativePattern:  "-n $",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ";",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "Non Numérique",
 		NumberDecimalDigits:      2,
@@ -5064,10 +5670,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -5089,10 +5698,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -5114,10 +5726,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "非数字",
 		NumberDecimalDigits:      2,
@@ -5139,10 +5754,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "非數字",
 		NumberDecimalDigits:      2,
@@ -5164,10 +5782,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "非數字",
 		NumberDecimalDigits:      2,
@@ -5189,10 +5810,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "($n)",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -5214,10 +5838,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$n",
 		CurrencyNegativePattern:  "-$n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "不是一個數字",
 		NumberDecimalDigits:      2,
@@ -5239,10 +5866,13 @@ var locales = map[string]*Locale{
 		CurrencyGroupSeparator:   ",",
 		CurrencyPositivePattern:  "$ n",
 		CurrencyNegativePattern:  "$-n",
+		CurrencyNegativeSign:     "-",
 		ListSeparator:            ",",
 		NegativeSign:             "-",
 		PositiveSign:             "+",
 		PercentSymbol:            "%",
+		PercentPositivePattern:   "n%",
+		PercentNegativePattern:   "-n%",
 		PerMilleSymbol:           "‰",
 		NaNSymbol:                "NaN",
 		NumberDecimalDigits:      2,
@@ -0,0 +1,89 @@
+package locale
+
+import (
+	"strings"
+)
+
+// A numbering system with its digits 0-9 and the decimal and group
+// separators used with them.
+type numberingSystem struct {
+	digits  string
+	decimal string
+	group   string
+}
+
+var (
+	arabicIndicDigits    = numberingSystem{"٠١٢٣٤٥٦٧٨٩", "٫", "٬"}
+	extArabicIndicDigits = numberingSystem{"۰۱۲۳۴۵۶۷۸۹", "٫", "٬"}
+)
+
+// The native numbering systems of the locales that do not use the ASCII
+// digits 0-9 by default, see http://unicode.org/cldr/charts/latest/by_type/numbers.numbering_systems.html
+var nativeDigits = map[string]numberingSystem{
+	"ar_AE":  arabicIndicDigits,
+	"ar_BH":  arabicIndicDigits,
+	"ar_EG":  arabicIndicDigits,
+	"ar_IQ":  arabicIndicDigits,
+	"ar_JO":  arabicIndicDigits,
+	"ar_KW":  arabicIndicDigits,
+	"ar_LB":  arabicIndicDigits,
+	"ar_OM":  arabicIndicDigits,
+	"ar_QA":  arabicIndicDigits,
+	"ar_SA":  arabicIndicDigits,
+	"ar_SY":  arabicIndicDigits,
+	"ar_YE":  arabicIndicDigits,
+	"fa_IR":  extArabicIndicDigits,
+	"prs_AF": extArabicIndicDigits,
+	"ps_AF":  extArabicIndicDigits,
+}
+
+// The languages written from right to left.
+var rtlLanguages = map[string]bool{
+	"ar":  true,
+	"dv":  true,
+	"fa":  true,
+	"he":  true,
+	"prs": true,
+	"ps":  true,
+	"syr": true,
+	"ug":  true,
+	"ur":  true,
+}
+
+// Returns the native digits 0-9 of the locale as a string of 10 runes,
+// e.g. "٠١٢٣٤٥٦٧٨٩" for ar_EG, or "" if the locale uses the ASCII digits.
+func (l *Locale) NativeDigits() string {
+	return nativeDigits[l.Code].digits
+}
+
+// Returns the decimal and group separators used with the native digits
+// of the locale (see NativeDigits), e.g. "٫" and "٬" for ar_EG, or the
+// currency separators of the locale if it uses the ASCII digits.
+func (l *Locale) NativeSeparators() (decimal, group string) {
+	if n, found := nativeDigits[l.Code]; found {
+		return n.decimal, n.group
+	}
+	return l.CurrencyDecimalSeparator, l.CurrencyGroupSeparator
+}
+
+// Returns s with the ASCII digits 0-9 replaced by the native digits of
+// the locale (see NativeDigits), e.g. "١٢٣" for "123" in ar_EG. Returns s
+// unchanged if the locale uses the ASCII digits.
+func (l *Locale) LocalizeDigits(s string) string {
+	digits := []rune(l.NativeDigits())
+	if len(digits) != 10 {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return digits[r-'0']
+		}
+		return r
+	}, s)
+}
+
+// Returns true if the language of the locale is written from right to
+// left, e.g. for ar_EG or he_IL.
+func (l *Locale) IsRTL() bool {
+	return rtlLanguages[l.Language]
+}
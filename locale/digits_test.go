@@ -0,0 +1,66 @@
+package locale
+
+import (
+	"testing"
+)
+
+func TestLocalizeDigits(t *testing.T) {
+	var tests = []struct {
+		code     string
+		s        string
+		expected string
+	}{
+		/* 0 */ {"ar_EG", "1,234.56", "١,٢٣٤.٥٦"},
+		/* 1 */ {"ar_SA", "0123456789", "٠١٢٣٤٥٦٧٨٩"},
+		/* 2 */ {"fa_IR", "1234", "۱۲۳۴"},
+		/* 3 */ {"ar_MA", "1234", "1234"},
+		/* 4 */ {"en_US", "1,234.56", "1,234.56"},
+		/* 5 */ {"ar_EG", "USD", "USD"},
+	}
+
+	for i, f := range tests {
+		if s := Get(f.code).LocalizeDigits(f.s); s != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, s)
+		}
+	}
+}
+
+func TestNativeSeparators(t *testing.T) {
+	var tests = []struct {
+		code    string
+		decimal string
+		group   string
+	}{
+		/* 0 */ {"ar_EG", "٫", "٬"},
+		/* 1 */ {"fa_IR", "٫", "٬"},
+		/* 2 */ {"ar_MA", ".", ","},
+		/* 3 */ {"en_US", ".", ","},
+	}
+
+	for i, f := range tests {
+		if decimal, group := Get(f.code).NativeSeparators(); decimal != f.decimal || group != f.group {
+			t.Errorf("%d. expected %v and %v, got %v and %v", i, f.decimal, f.group, decimal, group)
+		}
+	}
+}
+
+func TestIsRTL(t *testing.T) {
+	var tests = []struct {
+		code     string
+		expected bool
+	}{
+		/* 0 */ {"ar_EG", true},
+		/* 1 */ {"ar_MA", true},
+		/* 2 */ {"he_IL", true},
+		/* 3 */ {"fa_IR", true},
+		/* 4 */ {"ur_PK", true},
+		/* 5 */ {"en_US", false},
+		/* 6 */ {"de_DE", false},
+	}
+
+	for i, f := range tests {
+		if rtl := Get(f.code).IsRTL(); rtl != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, rtl)
+		}
+	}
+}
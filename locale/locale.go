@@ -1,5 +1,40 @@
 package locale
 
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrInvalidLocale = errors.New("i18n: invalid locale")
+
+	// mu guards locales and defaultCode, which may be changed while other
+	// goroutines look up locales.
+	mu          sync.RWMutex
+	defaultCode = "en_US"
+)
+
+// The territories of the locales to use for a language without a locale
+// of the form xx_XX, e.g. US for en (see GetWithFallback).
+var defaultTerritories = map[string]string{
+	"ar": "SA",
+	"cs": "CZ",
+	"da": "DK",
+	"el": "GR",
+	"en": "US",
+	"he": "IL",
+	"hi": "IN",
+	"ja": "JP",
+	"ko": "KR",
+	"nb": "NO",
+	"pt": "BR",
+	"sv": "SE",
+	"uk": "UA",
+	"zh": "CN",
+}
+
 // Locale contains all information about a locale.
 type Locale struct {
 	// Code is the ISO code of the locale in the xx_YY format, e.g. de_AT.
@@ -28,6 +63,9 @@ type Locale struct {
 	CurrencyPositivePattern string
 	// CurrencyNegativePattern is the pattern used for negative currency values.
 	CurrencyNegativePattern string
+	// CurrencyNegativeSign is the symbol that replaces the "-" in the
+	// currency negative pattern, e.g. "\u2212" (minus sign) for sv_SE.
+	CurrencyNegativeSign string
 	// ListSeparator is the seperator used for lists, e.g. a comma.
 	ListSeparator string
 	// NegativeSign is the symbol to be used for negative numeric values.
@@ -36,6 +74,11 @@ type Locale struct {
 	PositiveSign string
 	// PercentSymbol is the symbol to be used for percentages.
 	PercentSymbol string
+	// PercentPositivePattern is the pattern used for positive percentages,
+	// e.g. "n %" where n is the number and % the percent symbol.
+	PercentPositivePattern string
+	// PercentNegativePattern is the pattern used for negative percentages.
+	PercentNegativePattern string
 	// PerMilleSymbol is the symbol to be used for per-mille values.
 	PerMilleSymbol string
 	// NaNSymbol is the symbol to be used for non-numbers.
@@ -53,10 +96,144 @@ type Locale struct {
 	NumberNegativePattern string
 }
 
-func Get(code string) *Locale {
+// Returns the locale for the tag, or nil if there is no such locale. The
+// tag may use "-" or "_" as separator and any case, e.g. "en-us", "EN-US"
+// and "en_US" all return the locale en_US.
+func Get(tag string) *Locale {
+	return lookup(normalize(tag))
+}
+
+// Returns the locale with the code, or nil if there is no such locale.
+func lookup(code string) *Locale {
+	mu.RLock()
+	defer mu.RUnlock()
 	return locales[code]
 }
 
+// Registers a custom locale, e.g. a variant of a locale with other
+// separators, or overrides a known one, so that Get returns it. The code
+// is normalized like in Get, e.g. "de-ch" becomes de_CH, and the language
+// is taken from the code if it is empty. Returns ErrInvalidLocale if the
+// code is empty or has characters other than ASCII letters, digits and
+// separators. A copy of l is registered, so l can be modified afterwards
+// without affecting Get.
+func Register(l *Locale) error {
+	code := normalize(l.Code)
+	if code == "" {
+		return ErrInvalidLocale
+	}
+	for _, r := range code {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '_' {
+			return ErrInvalidLocale
+		}
+	}
+	r := *l
+	r.Code = code
+	if r.Language == "" {
+		r.Language = strings.SplitN(code, "_", 2)[0]
+	}
+	r.CurrencyGroupSizes = append([]int(nil), l.CurrencyGroupSizes...)
+	r.NumberGroupSizes = append([]int(nil), l.NumberGroupSizes...)
+
+	mu.Lock()
+	locales[code] = &r
+	mu.Unlock()
+	return nil
+}
+
+// Returns the locale for the tag like Get, falling back to a locale of
+// the same language and then to the default locale (see SetDefault) if
+// there is no locale for the tag. For a language, the locale of the
+// territory of the same name is used if there is one, e.g. de_DE for
+// "de-XX" or fr_FR for "fr", otherwise a well-known one, e.g. en_US for
+// "en", or the first one by code. Returns nil only if the default locale
+// is not known either.
+func GetWithFallback(tag string) *Locale {
+	if l := Get(tag); l != nil {
+		return l
+	}
+	code := normalize(tag)
+	lang := code
+	if i := strings.Index(code, "_"); i >= 0 {
+		lang = code[:i]
+	}
+	if l := forLanguage(lang); l != nil {
+		return l
+	}
+	return lookup(Default())
+}
+
+// Returns the locale to use for the language lang, or nil if there is
+// no locale of that language.
+func forLanguage(lang string) *Locale {
+	if l := lookup(lang + "_" + strings.ToUpper(lang)); l != nil {
+		return l
+	}
+	if t, found := defaultTerritories[lang]; found {
+		if l := lookup(lang + "_" + t); l != nil {
+			return l
+		}
+	}
+	for _, l := range List() {
+		if l.Language == lang {
+			return l
+		}
+	}
+	return nil
+}
+
+// Returns the code of the locale tag in the xx_YY format, e.g. de_AT for
+// "DE-at". Script subtags are title-cased, e.g. sr_Latn_RS.
+func normalize(tag string) string {
+	parts := strings.FieldsFunc(tag, func(r rune) bool {
+		return r == '_' || r == '-'
+	})
+	for i, p := range parts {
+		switch {
+		case i == 0:
+			parts[i] = strings.ToLower(p)
+		case len(p) == 4:
+			parts[i] = strings.ToUpper(p[:1]) + strings.ToLower(p[1:])
+		default:
+			parts[i] = strings.ToUpper(p)
+		}
+	}
+	return strings.Join(parts, "_")
+}
+
+// Returns the code of the default locale used by GetWithFallback.
+func Default() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return defaultCode
+}
+
+// Sets the default locale used by GetWithFallback (default is en_US).
+// The tag is normalized like in GetWithFallback.
+func SetDefault(tag string) {
+	mu.Lock()
+	defaultCode = normalize(tag)
+	mu.Unlock()
+}
+
+// Returns all locales sorted by code.
+func List() []*Locale {
+	mu.RLock()
+	defer mu.RUnlock()
+	codes := make([]string, 0, len(locales))
+	for code := range locales {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	list := make([]*Locale, len(codes))
+	for i, code := range codes {
+		list[i] = locales[code]
+	}
+	return list
+}
+
+// Returns the map of all locales by code. It must not be used
+// concurrently with Register.
 func Locales() map[string]*Locale {
 	return locales
 }
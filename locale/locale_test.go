@@ -51,3 +51,135 @@ func TestLocales(t *testing.T) {
 		}
 	}
 }
+
+func TestGetWithFallback(t *testing.T) {
+	var tests = []struct {
+		tag          string
+		expectedCode string
+	}{
+		/*  0 */ {"en_US", "en_US"},
+		/*  1 */ {"en-US", "en_US"},
+		/*  2 */ {"EN-us", "en_US"},
+		/*  3 */ {"en_GB", "en_GB"},
+		/*  4 */ {"en-XX", "en_US"},
+		/*  5 */ {"en", "en_US"},
+		/*  6 */ {"de", "de_DE"},
+		/*  7 */ {"DE-xx", "de_DE"},
+		/*  8 */ {"fr", "fr_FR"},
+		/*  9 */ {"sr-latn-rs", "sr_Latn_RS"},
+		/* 10 */ {"af", "af_ZA"},
+		/* 11 */ {"xy_ZZ", "en_US"},
+		/* 12 */ {"", "en_US"},
+	}
+
+	for i, f := range tests {
+		l := GetWithFallback(f.tag)
+		if l == nil {
+			t.Fatalf("%d. expected locale to be != nil", i)
+		}
+		if l.Code != f.expectedCode {
+			t.Errorf("%d. expected Code to be %v, got %v", i, f.expectedCode, l.Code)
+		}
+	}
+}
+
+func TestSetDefault(t *testing.T) {
+	defer SetDefault(Default())
+
+	SetDefault("de-ch")
+	if got := Default(); got != "de_CH" {
+		t.Errorf("expected default to be %v, got %v", "de_CH", got)
+	}
+	if l := GetWithFallback("xy"); l == nil || l.Code != "de_CH" {
+		t.Errorf("expected fallback to be %v, got %v", "de_CH", l)
+	}
+
+	SetDefault("xy")
+	if l := GetWithFallback("xy"); l != nil {
+		t.Errorf("expected no locale, got %v", l)
+	}
+}
+
+func TestList(t *testing.T) {
+	list := List()
+	if len(list) != len(locales) {
+		t.Fatalf("expected %d locales, got %d", len(locales), len(list))
+	}
+	for i, l := range list {
+		if i > 0 && list[i-1].Code >= l.Code {
+			t.Errorf("%d. expected %v to be sorted after %v", i, l.Code, list[i-1].Code)
+		}
+	}
+}
+
+func TestGetNormalizesTag(t *testing.T) {
+	var tests = []struct {
+		tags         []string
+		expectedCode string
+	}{
+		/* 0 */ {[]string{"en_US", "en-US", "en-us", "EN-US", "EN_us", "En-Us"}, "en_US"},
+		/* 1 */ {[]string{"de_AT", "de-at", "DE-AT", "de_at"}, "de_AT"},
+		/* 2 */ {[]string{"sr_Latn_RS", "sr-latn-rs", "SR-LATN-RS", "sr_LATN_rs"}, "sr_Latn_RS"},
+		/* 3 */ {[]string{"arn_CL", "ARN-cl"}, "arn_CL"},
+	}
+
+	for i, f := range tests {
+		expected := locales[f.expectedCode]
+		for _, tag := range f.tags {
+			if l := Get(tag); l != expected {
+				t.Errorf("%d. expected %v to return %v, got %v", i, tag, f.expectedCode, l)
+			}
+		}
+	}
+
+	for i, tag := range []string{"", "en", "xy-ZZ", "en-US-x"} {
+		if l := Get(tag); l != nil {
+			t.Errorf("%d. expected %v to return nil, got %v", i, tag, l.Code)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	orig := locales["de_CH"]
+	defer func() {
+		mu.Lock()
+		locales["de_CH"] = orig
+		delete(locales, "en_ZZ")
+		mu.Unlock()
+	}()
+
+	l := *Get("en_US")
+	l.Code = "en-zz"
+	l.Language = ""
+	l.Territory = "ZZ"
+	l.CurrencyGroupSeparator = "'"
+	if err := Register(&l); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	got := Get("en_ZZ")
+	if got == nil || got.Code != "en_ZZ" || got.Language != "en" || got.CurrencyGroupSeparator != "'" {
+		t.Errorf("expected en_ZZ to be registered, got %v", got)
+	}
+	if GetWithFallback("en-ZZ") != got {
+		t.Errorf("expected GetWithFallback to return en_ZZ")
+	}
+
+	// Registered locales are copies
+	l.CurrencyGroupSeparator = "_"
+	if Get("en_ZZ").CurrencyGroupSeparator != "'" {
+		t.Errorf("expected group separator %v, got %v", "'", Get("en_ZZ").CurrencyGroupSeparator)
+	}
+
+	ch := *orig
+	ch.CurrencyGroupSeparator = " "
+	if err := Register(&ch); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if Get("de_CH").CurrencyGroupSeparator != " " {
+		t.Errorf("expected de_CH to be overridden, got %v", Get("de_CH").CurrencyGroupSeparator)
+	}
+
+	if err := Register(&Locale{Code: " - "}); err != ErrInvalidLocale {
+		t.Errorf("expected error %v, got %v", ErrInvalidLocale, err)
+	}
+}
@@ -0,0 +1,94 @@
+package locale
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Formats the number value with the given number of decimal places using
+// the number separators, group sizes and negative pattern of the locale,
+// e.g. "1,234.5" for en_US or "1.234,5" for de_DE with 1 decimal place.
+func (l *Locale) FormatNumber(value float64, decimals int) string {
+	if decimals < 0 {
+		decimals = 0
+	}
+	s := strconv.FormatFloat(math.Abs(value), 'f', decimals, 64)
+	whole, frac := s, ""
+	if i := strings.Index(s, "."); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+
+	formatted := GroupDigits(whole, l.NumberGroupSizes, l.NumberGroupSeparator)
+	if frac != "" {
+		formatted += l.NumberDecimalSeparator + frac
+	}
+	if value < 0 && strings.Trim(s, "0.") != "" {
+		pattern := l.NumberNegativePattern
+		if pattern == "" {
+			pattern = "-n"
+		}
+		return strings.Replace(pattern, "n", formatted, 1)
+	}
+	return formatted
+}
+
+// Formats the ratio as a percentage with the given number of decimal
+// places using the number separators and the percent patterns and symbol
+// of the locale, e.g. "12.5%" for en_US or "12,5 %" for fr_FR for a
+// ratio of 0.125 with 1 decimal place.
+func (l *Locale) FormatPercent(ratio float64, decimals int) string {
+	formatted := l.FormatNumber(math.Abs(ratio*100), decimals)
+	negative := ratio < 0 && formatted != l.FormatNumber(0, decimals)
+
+	pattern := l.PercentPositivePattern
+	if negative {
+		pattern = l.PercentNegativePattern
+	}
+	switch {
+	case pattern == "" && negative:
+		pattern = "-n%"
+	case pattern == "":
+		pattern = "n%"
+	}
+	symbol := l.PercentSymbol
+	if symbol == "" {
+		symbol = "%"
+	}
+	return strings.NewReplacer("%", symbol, "n", formatted).Replace(pattern)
+}
+
+// Inserts sep between the groups of the string of digits s. The first of
+// the group sizes applies to the rightmost group, the next one to the
+// group to its left and so on, with the last size repeating for all
+// remaining groups, e.g. sizes of 3 and 2 yield 12,34,56,789. A size of
+// zero leaves the remaining digits ungrouped. Without group sizes, or
+// with a single size of zero, s is returned unchanged.
+func GroupDigits(s string, sizes []int, sep string) string {
+	if len(sizes) == 0 {
+		return s
+	}
+	var groups []string
+	for i := 0; len(s) > 0; i++ {
+		size := sizes[len(sizes)-1]
+		if i < len(sizes) {
+			size = sizes[i]
+		}
+		if size <= 0 || size >= len(s) {
+			groups = append(groups, s)
+			break
+		}
+		groups = append(groups, s[len(s)-size:])
+		s = s[:len(s)-size]
+	}
+
+	var buf bytes.Buffer
+	for i := len(groups) - 1; i >= 0; i-- {
+		buf.WriteString(groups[i])
+		if i > 0 {
+			buf.WriteString(sep)
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,121 @@
+package locale
+
+import (
+	"testing"
+)
+
+func TestFormatNumber(t *testing.T) {
+	var tests = []struct {
+		code     string
+		value    float64
+		decimals int
+		expected string
+	}{
+		/*  0 */ {"en_US", 1234.5, 1, "1,234.5"},
+		/*  1 */ {"en_US", -1234.5, 2, "-1,234.50"},
+		/*  2 */ {"en_US", 1234567, 0, "1,234,567"},
+		/*  3 */ {"en_US", 0.126, 2, "0.13"},
+		/*  4 */ {"en_US", -0.001, 2, "0.00"},
+		/*  5 */ {"en_US", 12, -1, "12"},
+		/*  6 */ {"de_DE", 1234.5, 1, "1.234,5"},
+		/*  7 */ {"de_DE", -1234567.891, 2, "-1.234.567,89"},
+		/*  8 */ {"en_IN", 1234567.5, 1, "12,34,567.5"},
+		/*  9 */ {"hi_IN", 123456789, 0, "12,34,56,789"},
+		/* 10 */ {"fr_FR", 1234.5, 1, "1\u00a0234,5"},
+		/* 11 */ {"de_CH", 1234567.5, 1, "1'234'567.5"},
+	}
+
+	for i, f := range tests {
+		l := Get(f.code)
+		if l == nil {
+			t.Fatalf("%d. expected locale %v to be found", i, f.code)
+		}
+		if got := l.FormatNumber(f.value, f.decimals); got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+	}
+}
+
+func TestFormatNumberNegativePattern(t *testing.T) {
+	for i, l := range List() {
+		got := l.FormatNumber(-1, 0)
+		var expected string
+		switch l.NumberNegativePattern {
+		case "-n":
+			expected = "-1"
+		case "- n":
+			expected = "- 1"
+		case "n-":
+			expected = "1-"
+		case "(n)":
+			expected = "(1)"
+		default:
+			continue
+		}
+		if got != expected {
+			t.Errorf("%d. expected %v to be %q, got %q", i, l.Code, expected, got)
+		}
+	}
+}
+
+func TestGroupDigits(t *testing.T) {
+	var tests = []struct {
+		s        string
+		sizes    []int
+		expected string
+	}{
+		/* 0 */ {"0", []int{3}, "0"},
+		/* 1 */ {"123", []int{3}, "123"},
+		/* 2 */ {"1234", []int{3}, "1,234"},
+		/* 3 */ {"1234567", []int{3}, "1,234,567"},
+		/* 4 */ {"1234567", []int{3, 2}, "12,34,567"},
+		/* 5 */ {"123456789", []int{1, 2, 3}, "123,456,78,9"},
+		/* 6 */ {"1234567", []int{3, 0}, "1234,567"},
+		/* 7 */ {"1234567", []int{0}, "1234567"},
+		/* 8 */ {"1234567", nil, "1234567"},
+		/* 9 */ {"1234567", []int{}, "1234567"},
+	}
+
+	for i, f := range tests {
+		if got := GroupDigits(f.s, f.sizes, ","); got != f.expected {
+			t.Errorf("%d. expected %s, got %s (sizes: %v)", i, f.expected, got, f.sizes)
+		}
+	}
+}
+
+func TestFormatPercent(t *testing.T) {
+	var tests = []struct {
+		code     string
+		ratio    float64
+		decimals int
+		expected string
+	}{
+		/*  0 */ {"en_US", 0.125, 1, "12.5%"},
+		/*  1 */ {"en_US", -0.125, 1, "-12.5%"},
+		/*  2 */ {"en_US", 12.3456, 0, "1,235%"},
+		/*  3 */ {"en_US", -0.00001, 1, "0.0%"},
+		/*  4 */ {"fr_FR", 0.125, 1, "12,5\u00a0%"},
+		/*  5 */ {"fr_FR", -0.125, 2, "-12,50\u00a0%"},
+		/*  6 */ {"de_DE", 0.5, 0, "50\u00a0%"},
+		/*  7 */ {"tr_TR", 0.125, 1, "%12,5"},
+		/*  8 */ {"tr_TR", -0.125, 1, "-%12,5"},
+		/*  9 */ {"eu_ES", 0.125, 1, "%\u00a012,5"},
+		/* 10 */ {"en_US", 0, 2, "0.00%"},
+		/* 11 */ {"fr_FR", 1.5, 0, "150\u00a0%"},
+	}
+
+	for i, f := range tests {
+		l := Get(f.code)
+		if l == nil {
+			t.Fatalf("%d. expected locale %v to be found", i, f.code)
+		}
+		if got := l.FormatPercent(f.ratio, f.decimals); got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+	}
+
+	l := &Locale{NumberDecimalSeparator: ".", NumberGroupSeparator: ","}
+	if got := l.FormatPercent(-0.25, 0); got != "-25%" {
+		t.Errorf("expected %q, got %q", "-25%", got)
+	}
+}
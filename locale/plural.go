@@ -0,0 +1,118 @@
+package locale
+
+import (
+	"strings"
+)
+
+// PluralCategory is a CLDR plural category, which selects the form of a
+// word for a count, e.g. "one" for "1 dollar" and "other" for "2 dollars".
+type PluralCategory string
+
+const (
+	PluralZero  PluralCategory = "zero"
+	PluralOne   PluralCategory = "one"
+	PluralTwo   PluralCategory = "two"
+	PluralFew   PluralCategory = "few"
+	PluralMany  PluralCategory = "many"
+	PluralOther PluralCategory = "other"
+)
+
+// A pluralRule returns the plural category of the count n.
+type pluralRule func(n int64) PluralCategory
+
+// The plural rules for integer counts by language, see
+// http://unicode.org/cldr/charts/latest/supplemental/language_plural_rules.html
+var pluralRules = map[string]pluralRule{
+	"cs": pluralCzech,
+	"da": pluralOneOther,
+	"de": pluralOneOther,
+	"en": pluralOneOther,
+	"es": pluralOneOther,
+	"fi": pluralOneOther,
+	"fr": pluralFrench,
+	"id": pluralOther,
+	"it": pluralOneOther,
+	"ja": pluralOther,
+	"ko": pluralOther,
+	"nb": pluralOneOther,
+	"nl": pluralOneOther,
+	"pl": pluralPolish,
+	"pt": pluralFrench,
+	"ru": pluralRussian,
+	"sk": pluralCzech,
+	"sv": pluralOneOther,
+	"th": pluralOther,
+	"tr": pluralOneOther,
+	"uk": pluralRussian,
+	"vi": pluralOther,
+	"zh": pluralOther,
+}
+
+// Returns the plural category of the count n in the language of the tag,
+// e.g. PluralFew for 2 and PluralMany for 5 in "pl". The tag may be a
+// language or a locale, e.g. "en" or "en_US". Languages without plural
+// rules always return PluralOther.
+func Plural(tag string, n int64) PluralCategory {
+	lang := normalize(tag)
+	if i := strings.Index(lang, "_"); i >= 0 {
+		lang = lang[:i]
+	}
+	if rule, found := pluralRules[lang]; found {
+		return rule(n)
+	}
+	return PluralOther
+}
+
+func pluralOther(n int64) PluralCategory {
+	return PluralOther
+}
+
+func pluralOneOther(n int64) PluralCategory {
+	if n == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+func pluralFrench(n int64) PluralCategory {
+	if n == 0 || n == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+func pluralCzech(n int64) PluralCategory {
+	switch {
+	case n == 1:
+		return PluralOne
+	case n >= 2 && n <= 4:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+func pluralPolish(n int64) PluralCategory {
+	if n < 0 {
+		n = -n
+	}
+	switch i10, i100 := n%10, n%100; {
+	case n == 1:
+		return PluralOne
+	case i10 >= 2 && i10 <= 4 && (i100 < 12 || i100 > 14):
+		return PluralFew
+	}
+	return PluralMany
+}
+
+func pluralRussian(n int64) PluralCategory {
+	if n < 0 {
+		n = -n
+	}
+	switch i10, i100 := n%10, n%100; {
+	case i10 == 1 && i100 != 11:
+		return PluralOne
+	case i10 >= 2 && i10 <= 4 && (i100 < 12 || i100 > 14):
+		return PluralFew
+	}
+	return PluralMany
+}
@@ -0,0 +1,41 @@
+package locale
+
+import (
+	"testing"
+)
+
+func TestPlural(t *testing.T) {
+	var tests = []struct {
+		tag      string
+		n        int64
+		expected PluralCategory
+	}{
+		/*  0 */ {"en", 0, PluralOther},
+		/*  1 */ {"en", 1, PluralOne},
+		/*  2 */ {"en_US", 2, PluralOther},
+		/*  3 */ {"en-GB", 5, PluralOther},
+		/*  4 */ {"fr", 0, PluralOne},
+		/*  5 */ {"fr", 2, PluralOther},
+		/*  6 */ {"pl", 0, PluralMany},
+		/*  7 */ {"pl", 1, PluralOne},
+		/*  8 */ {"pl_PL", 2, PluralFew},
+		/*  9 */ {"pl", 5, PluralMany},
+		/* 10 */ {"pl", 12, PluralMany},
+		/* 11 */ {"pl", 22, PluralFew},
+		/* 12 */ {"pl", 21, PluralMany},
+		/* 13 */ {"ru", 21, PluralOne},
+		/* 14 */ {"ru", 11, PluralMany},
+		/* 15 */ {"ru", 3, PluralFew},
+		/* 16 */ {"cs", 3, PluralFew},
+		/* 17 */ {"cs", 5, PluralOther},
+		/* 18 */ {"ja", 1, PluralOther},
+		/* 19 */ {"xy", 1, PluralOther},
+		/* 20 */ {"pl", -2, PluralFew},
+	}
+
+	for i, f := range tests {
+		if got := Plural(f.tag, f.n); got != f.expected {
+			t.Errorf("%d. expected %v for %d, got %v", i, f.expected, f.n, got)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package money
+
+// Returns the total of all items as a new Money, leaving the items
+// unchanged. Sum returns ErrCurrencyMismatch if the items have different
+// currencies and ErrMoneyOverflow if the total overflows. The sum of no
+// items is Zero(""), which can be added to Money of any currency. Use
+// Zero to start a running total instead.
+func Sum(items ...*Money) (*Money, error) {
+	if len(items) == 0 {
+		return Zero(""), nil
+	}
+	total := items[0].withAmount(items[0].M)
+	for _, item := range items[1:] {
+		if _, err := total.AddErr(item); err != nil {
+			return nil, err
+		}
+	}
+	return total, nil
+}
+
+// Returns a copy of the largest of the items. Max returns
+// ErrCurrencyMismatch if the items have different currencies and
+// ErrMoneyNoItems if there are no items.
+func Max(items ...*Money) (*Money, error) {
+	return extreme(items, 1)
+}
+
+// Returns a copy of the smallest of the items. Min returns
+// ErrCurrencyMismatch if the items have different currencies and
+// ErrMoneyNoItems if there are no items.
+func Min(items ...*Money) (*Money, error) {
+	return extreme(items, -1)
+}
+
+// Returns a copy of the item that compares as sign against all others.
+func extreme(items []*Money, sign int) (*Money, error) {
+	if len(items) == 0 {
+		return nil, ErrMoneyNoItems
+	}
+	best := items[0]
+	c := best.C
+	for _, item := range items[1:] {
+		var err error
+		if c, err = commonCurrency(&Money{C: c}, item); err != nil {
+			return nil, err
+		}
+		if r, _ := item.CmpErr(best); r == sign {
+			best = item
+		}
+	}
+	return best.withAmount(best.M), nil
+}
@@ -0,0 +1,100 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	items := []*Money{New(100, "USD"), New(250, "USD"), New(-50, "USD")}
+	got, err := Sum(items...)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.M != 300 || got.C != "USD" {
+		t.Errorf("expected %v, got %v", "3.00 USD", got)
+	}
+	if items[0].M != 100 {
+		t.Errorf("expected items to be unchanged, got %v", items[0].M)
+	}
+
+	got, err = Sum(New(100, "USD"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.M != 100 || got.C != "USD" {
+		t.Errorf("expected %v, got %v", "1.00 USD", got)
+	}
+}
+
+func TestSumEmpty(t *testing.T) {
+	got, err := Sum()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.M != 0 || got.C != "" {
+		t.Errorf("expected zero Money without currency, got %v", got)
+	}
+}
+
+func TestSumErrors(t *testing.T) {
+	_, err := Sum(New(100, "USD"), New(100, "EUR"))
+	if err != ErrCurrencyMismatch {
+		t.Errorf("expected error %v, got %v", ErrCurrencyMismatch, err)
+	}
+	_, err = Sum(New(math.MaxInt64, "USD"), New(1, "USD"))
+	if err != ErrMoneyOverflow {
+		t.Errorf("expected error %v, got %v", ErrMoneyOverflow, err)
+	}
+}
+
+func TestMaxMin(t *testing.T) {
+	var fixtures = []struct {
+		items []*Money
+		max   int64
+		min   int64
+	}{
+		{[]*Money{New(100, "USD")}, 100, 100},
+		{[]*Money{New(100, "USD"), New(250, "USD"), New(-50, "USD")}, 250, -50},
+		{[]*Money{New(-100, "USD"), New(-250, "USD"), New(-50, "USD")}, -50, -250},
+		{[]*Money{New(0, "USD"), New(0, "USD")}, 0, 0},
+		{[]*Money{New(100, ""), New(250, "USD")}, 250, 100},
+	}
+
+	for i, f := range fixtures {
+		max, err := Max(f.items...)
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if max.M != f.max {
+			t.Errorf("%d. expected Max to be %v, got %v", i, f.max, max.M)
+		}
+		min, err := Min(f.items...)
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if min.M != f.min {
+			t.Errorf("%d. expected Min to be %v, got %v", i, f.min, min.M)
+		}
+		for _, item := range f.items {
+			if item == max || item == min {
+				t.Errorf("%d. expected a copy of the item", i)
+			}
+		}
+	}
+}
+
+func TestMaxMinErrors(t *testing.T) {
+	if _, err := Max(); err != ErrMoneyNoItems {
+		t.Errorf("expected error %v, got %v", ErrMoneyNoItems, err)
+	}
+	if _, err := Min(); err != ErrMoneyNoItems {
+		t.Errorf("expected error %v, got %v", ErrMoneyNoItems, err)
+	}
+	if _, err := Max(New(100, "USD"), New(100, ""), New(100, "EUR")); err != ErrCurrencyMismatch {
+		t.Errorf("expected error %v, got %v", ErrCurrencyMismatch, err)
+	}
+	if _, err := Min(New(100, "USD"), New(100, "EUR")); err != ErrCurrencyMismatch {
+		t.Errorf("expected error %v, got %v", ErrCurrencyMismatch, err)
+	}
+}
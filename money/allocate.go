@@ -0,0 +1,122 @@
+package money
+
+import (
+	"math/big"
+)
+
+// The maximum number of installments of SplitInto.
+const maxInstallments = 1 << 20
+
+// Splits m into n parts that add up exactly to m. The minor units that
+// cannot be divided evenly are handed out one at a time to the first
+// parts, e.g. 100 split into 3 parts yields 34, 33 and 33. Each part has
+// the currency of m, and m itself is left unchanged. Allocate returns
+// ErrMoneyInvalidAllocation if n is zero or negative.
+func (m *Money) Allocate(n int) ([]*Money, error) {
+	if n <= 0 {
+		return nil, ErrMoneyInvalidAllocation
+	}
+	q := m.M / int64(n)
+	r := m.M % int64(n)
+	one := int64(1)
+	if r < 0 {
+		r, one = -r, -1
+	}
+	parts := make([]*Money, n)
+	for i := range parts {
+		parts[i] = m.withAmount(q)
+		if int64(i) < r {
+			parts[i].M += one
+		}
+	}
+	return parts, nil
+}
+
+// Splits m into parts weighted by ratios, e.g. 100 split by 1, 1 and 2
+// yields 25, 25 and 50. Each part is first computed as m*ratio/sum, and
+// the remaining minor units are then handed out one at a time to the
+// parts with a non-zero ratio, in order. The parts always add up exactly
+// to m. Each part has the currency of m, and m itself is left unchanged.
+// AllocateByRatios returns ErrMoneyInvalidAllocation if ratios is empty,
+// contains a negative ratio or sums up to zero.
+func (m *Money) AllocateByRatios(ratios []int) ([]*Money, error) {
+	if len(ratios) == 0 {
+		return nil, ErrMoneyInvalidAllocation
+	}
+	sum := new(big.Int)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, ErrMoneyInvalidAllocation
+		}
+		sum.Add(sum, big.NewInt(int64(ratio)))
+	}
+	if sum.Sign() == 0 {
+		return nil, ErrMoneyInvalidAllocation
+	}
+
+	total := big.NewInt(m.M)
+	parts := make([]*Money, len(ratios))
+	r := m.M
+	for i, ratio := range ratios {
+		x := new(big.Int).Mul(total, big.NewInt(int64(ratio)))
+		x.Quo(x, sum)
+		parts[i] = m.withAmount(x.Int64())
+		r -= parts[i].M
+	}
+	one := int64(1)
+	if r < 0 {
+		r, one = -r, -1
+	}
+	for i := 0; r > 0; i++ {
+		if ratios[i] > 0 {
+			parts[i].M += one
+			r--
+		}
+	}
+	return parts, nil
+}
+
+// Splits m into installments of the given amount followed by a smaller
+// final installment for the rest, if any, so that they add up exactly to
+// m, e.g. 125.00 split into installments of 50.00 yields 50.00, 50.00 and
+// 25.00. Negative amounts are split into negative installments. m is
+// left unchanged. SplitInto returns ErrCurrencyMismatch if the currencies
+// differ and ErrMoneyInvalidAllocation if the installment is zero or
+// negative or if there would be more than 2^20 installments.
+func (m *Money) SplitInto(installment *Money) ([]*Money, error) {
+	c, err := commonCurrency(m, installment)
+	if err != nil {
+		return nil, err
+	}
+	if installment.M <= 0 {
+		return nil, ErrMoneyInvalidAllocation
+	}
+	a, b, dp, err := align(m, installment)
+	if err != nil {
+		return nil, err
+	}
+	if a < 0 {
+		b = -b
+	}
+
+	part := func(x int64) *Money {
+		p := &Money{M: x, C: c, dp: m.dp}
+		if dp != m.scale() {
+			p.dp = dp
+		}
+		return p
+	}
+	// n is negative if a/b overflows for MinInt64 / -1
+	n, r := a/b, a%b
+	if n < 0 || n > maxInstallments || (n == maxInstallments && r != 0) {
+		return nil, ErrMoneyInvalidAllocation
+	}
+	parts := make([]*Money, 0, n+1)
+	for i := int64(0); i < n; i++ {
+		parts = append(parts, part(b))
+	}
+	if r != 0 {
+		parts = append(parts, part(r))
+	}
+	return parts, nil
+}
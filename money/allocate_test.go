@@ -0,0 +1,197 @@
+package money
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestAllocate(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		n        int
+		expected []int64
+	}{
+		{New(100, "USD"), 1, []int64{100}},
+		{New(100, "USD"), 2, []int64{50, 50}},
+		{New(100, "USD"), 3, []int64{34, 33, 33}},
+		{New(101, "USD"), 3, []int64{34, 34, 33}},
+		{New(2, "USD"), 3, []int64{1, 1, 0}},
+		{New(0, "USD"), 3, []int64{0, 0, 0}},
+		{New(-100, "USD"), 3, []int64{-34, -33, -33}},
+	}
+
+	for i, f := range fixtures {
+		parts, err := f.m.Allocate(f.n)
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if len(parts) != len(f.expected) {
+			t.Fatalf("%d. expected %d parts, got %d", i, len(f.expected), len(parts))
+		}
+		var sum int64
+		for j, p := range parts {
+			if p.M != f.expected[j] {
+				t.Errorf("%d. expected part %d to be %v, got %v", i, j, f.expected[j], p.M)
+			}
+			if p.C != f.m.C {
+				t.Errorf("%d. expected part %d currency to be %v, got %v", i, j, f.m.C, p.C)
+			}
+			sum += p.M
+		}
+		if sum != f.m.M {
+			t.Errorf("%d. expected parts to sum to %v, got %v", i, f.m.M, sum)
+		}
+	}
+}
+
+func TestAllocateInvalid(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		_, err := New(100, "USD").Allocate(n)
+		if err != ErrMoneyInvalidAllocation {
+			t.Errorf("expected error %v for %d parts, got %v", ErrMoneyInvalidAllocation, n, err)
+		}
+	}
+}
+
+func TestAllocateByRatios(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		ratios   []int
+		expected []int64
+	}{
+		{New(100, "USD"), []int{1}, []int64{100}},
+		{New(100, "USD"), []int{1, 1, 2}, []int64{25, 25, 50}},
+		{New(100, "USD"), []int{1, 1, 1}, []int64{34, 33, 33}},
+		{New(5, "USD"), []int{3, 7}, []int64{2, 3}},
+		{New(100, "USD"), []int{0, 1, 1}, []int64{0, 50, 50}},
+		{New(101, "USD"), []int{0, 1, 1}, []int64{0, 51, 50}},
+		{New(-100, "USD"), []int{1, 1, 1}, []int64{-34, -33, -33}},
+		{New(math.MaxInt64, "USD"), []int{math.MaxInt32, math.MaxInt32}, []int64{math.MaxInt64/2 + 1, math.MaxInt64 / 2}},
+	}
+
+	for i, f := range fixtures {
+		parts, err := f.m.AllocateByRatios(f.ratios)
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if len(parts) != len(f.expected) {
+			t.Fatalf("%d. expected %d parts, got %d", i, len(f.expected), len(parts))
+		}
+		for j, p := range parts {
+			if p.M != f.expected[j] {
+				t.Errorf("%d. expected part %d to be %v, got %v", i, j, f.expected[j], p.M)
+			}
+			if p.C != f.m.C {
+				t.Errorf("%d. expected part %d currency to be %v, got %v", i, j, f.m.C, p.C)
+			}
+		}
+	}
+}
+
+func TestAllocateByRatiosInvalid(t *testing.T) {
+	var fixtures = [][]int{
+		nil,
+		[]int{},
+		[]int{1, -1},
+		[]int{0, 0},
+	}
+
+	for i, ratios := range fixtures {
+		_, err := New(100, "USD").AllocateByRatios(ratios)
+		if err != ErrMoneyInvalidAllocation {
+			t.Errorf("%d. expected error %v, got %v", i, ErrMoneyInvalidAllocation, err)
+		}
+	}
+}
+
+func TestAllocateByRatiosSum(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		m := New(rnd.Int63()-rnd.Int63(), "USD")
+		ratios := make([]int, 1+rnd.Intn(10))
+		for j := range ratios {
+			ratios[j] = rnd.Intn(1000)
+		}
+		ratios[rnd.Intn(len(ratios))]++
+
+		parts, err := m.AllocateByRatios(ratios)
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		var sum int64
+		for _, p := range parts {
+			sum += p.M
+		}
+		if sum != m.M {
+			t.Fatalf("%d. expected parts of %v split by %v to sum to %v, got %v", i, m.M, ratios, m.M, sum)
+		}
+	}
+}
+
+func TestSplitInto(t *testing.T) {
+	var fixtures = []struct {
+		m           *Money
+		installment *Money
+		expected    []int64
+	}{
+		{New(12500, "USD"), New(5000, "USD"), []int64{5000, 5000, 2500}},
+		{New(15000, "USD"), New(5000, "USD"), []int64{5000, 5000, 5000}},
+		{New(2500, "USD"), New(5000, "USD"), []int64{2500}},
+		{New(-12500, "USD"), New(5000, "USD"), []int64{-5000, -5000, -2500}},
+		{New(0, "USD"), New(5000, "USD"), []int64{}},
+		{New(3, "USD"), New(1, "USD"), []int64{1, 1, 1}},
+		{New(1000, "USD"), NewWithScale(3333, "USD", 3), []int64{3333, 3333, 3333, 1}},
+	}
+
+	for i, f := range fixtures {
+		orig := *f.m
+		parts, err := f.m.SplitInto(f.installment)
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if len(parts) != len(f.expected) {
+			t.Fatalf("%d. expected %d installments, got %d", i, len(f.expected), len(parts))
+		}
+		sum := New(0, f.m.C)
+		for j, p := range parts {
+			if p.M != f.expected[j] || p.C != f.m.C {
+				t.Errorf("%d. expected installment %d to be %v, got %v", i, j, f.expected[j], p.M)
+			}
+			sum.Add(p)
+		}
+		if !sum.Equals(f.m) {
+			t.Errorf("%d. expected installments to add up to %v, got %v", i, f.m, sum)
+		}
+		if *f.m != orig {
+			t.Errorf("%d. expected m to be unchanged, got %v", i, f.m)
+		}
+	}
+}
+
+func TestSplitIntoErrors(t *testing.T) {
+	var fixtures = []struct {
+		installment *Money
+		err         error
+	}{
+		{New(0, "USD"), ErrMoneyInvalidAllocation},
+		{New(-5000, "USD"), ErrMoneyInvalidAllocation},
+		{New(5000, "EUR"), ErrCurrencyMismatch},
+	}
+
+	for i, f := range fixtures {
+		if _, err := New(12500, "USD").SplitInto(f.installment); err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+	}
+
+	// Too many installments
+	for i, m := range []*Money{New(math.MaxInt64, "USD"), New(math.MinInt64, "USD"), New(1<<20+1, "USD")} {
+		if _, err := m.SplitInto(New(1, "USD")); err != ErrMoneyInvalidAllocation {
+			t.Errorf("%d. expected error %v, got %v", i, ErrMoneyInvalidAllocation, err)
+		}
+	}
+	if parts, err := New(1<<20, "USD").SplitInto(New(1, "USD")); err != nil || len(parts) != 1<<20 {
+		t.Errorf("expected %d installments, got %d and error %v", 1<<20, len(parts), err)
+	}
+}
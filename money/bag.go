@@ -0,0 +1,90 @@
+package money
+
+import (
+	"sort"
+)
+
+// MoneyBag holds amounts of several currencies, e.g. the items of a cart
+// that mixes USD and EUR. Money added to the bag is merged with the
+// amount of the same currency. The zero value is an empty bag.
+type MoneyBag struct {
+	items map[string]*Money
+}
+
+// NewMoneyBag returns a new MoneyBag with the given items added.
+func NewMoneyBag(items ...*Money) (*MoneyBag, error) {
+	b := new(MoneyBag)
+	for _, m := range items {
+		if err := b.Add(m); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Adds m to the amount of its currency in the bag. m is left unchanged.
+// Add returns ErrMoneyOverflow if the amount overflows, in which case
+// the bag is left unchanged.
+func (b *MoneyBag) Add(m *Money) error {
+	if b.items == nil {
+		b.items = make(map[string]*Money)
+	}
+	total, found := b.items[m.C]
+	if !found {
+		b.items[m.C] = m.withAmount(m.M)
+		return nil
+	}
+	x, err := total.withAmount(total.M).AddErr(m)
+	if err != nil {
+		return err
+	}
+	b.items[m.C] = x
+	return nil
+}
+
+// Returns the currency codes in the bag, sorted.
+func (b *MoneyBag) Currencies() []string {
+	codes := make([]string, 0, len(b.items))
+	for c := range b.items {
+		codes = append(codes, c)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Returns a copy of the amount of the currency c in the bag, or nil if
+// the bag has no amount of that currency.
+func (b *MoneyBag) Get(c string) *Money {
+	m, found := b.items[c]
+	if !found {
+		return nil
+	}
+	return m.withAmount(m.M)
+}
+
+// Returns copies of the amounts in the bag, one per currency, sorted by
+// currency code.
+func (b *MoneyBag) Items() []*Money {
+	items := make([]*Money, 0, len(b.items))
+	for _, c := range b.Currencies() {
+		items = append(items, b.Get(c))
+	}
+	return items
+}
+
+// Converts all amounts in the bag into currency to with exchange rates
+// from p (see ConvertVia) and returns their total. The bag is left
+// unchanged. The total of an empty bag is zero.
+func (b *MoneyBag) Convert(to string, p RateProvider) (*Money, error) {
+	total := New(0, to)
+	for _, m := range b.Items() {
+		x, err := m.ConvertVia(to, p)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := total.AddErr(x); err != nil {
+			return nil, err
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,98 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMoneyBag(t *testing.T) {
+	b, err := NewMoneyBag(New(1000, "USD"), New(250, "EUR"), New(550, "USD"), New(-50, "EUR"), New(1, "GBP"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var expected = []string{"2.00 EUR", "0.01 GBP", "15.50 USD"}
+	items := b.Items()
+	if len(items) != len(expected) {
+		t.Fatalf("expected %d items, got %d", len(expected), len(items))
+	}
+	for i, m := range items {
+		if m.String() != expected[i] {
+			t.Errorf("%d. expected %v, got %v", i, expected[i], m)
+		}
+	}
+
+	codes := b.Currencies()
+	for i, c := range []string{"EUR", "GBP", "USD"} {
+		if codes[i] != c {
+			t.Errorf("%d. expected currency %v, got %v", i, c, codes[i])
+		}
+	}
+
+	// Items are copies
+	items[0].Set(0)
+	if got := b.Get("EUR"); got.M != 200 {
+		t.Errorf("expected bag to be unchanged, got %v", got)
+	}
+	if got := b.Get("JPY"); got != nil {
+		t.Errorf("expected no JPY, got %v", got)
+	}
+}
+
+func TestMoneyBagZeroValue(t *testing.T) {
+	var b MoneyBag
+	if got := len(b.Items()); got != 0 {
+		t.Errorf("expected no items, got %d", got)
+	}
+	m := New(100, "USD")
+	if err := b.Add(m); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := b.Add(m); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := b.Get("USD"); got.M != 200 {
+		t.Errorf("expected %v, got %v", 200, got.M)
+	}
+	if m.M != 100 {
+		t.Errorf("expected added Money to be unchanged, got %v", m.M)
+	}
+}
+
+func TestMoneyBagOverflow(t *testing.T) {
+	b, _ := NewMoneyBag(New(math.MaxInt64, "USD"))
+	if err := b.Add(New(1, "USD")); err != ErrMoneyOverflow {
+		t.Errorf("expected error %v, got %v", ErrMoneyOverflow, err)
+	}
+	if got := b.Get("USD"); got.M != math.MaxInt64 {
+		t.Errorf("expected bag to be unchanged, got %v", got.M)
+	}
+}
+
+func TestMoneyBagConvert(t *testing.T) {
+	rates := NewStaticRates(
+		Rate{From: "EUR", To: "USD", Ratio: 1.25},
+		Rate{From: "GBP", To: "USD", Ratio: 1.5},
+	)
+	b, _ := NewMoneyBag(New(1000, "USD"), New(800, "EUR"), New(200, "GBP"))
+
+	total, err := b.Convert("USD", rates)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := total.String(); got != "23.00 USD" {
+		t.Errorf("expected %v, got %v", "23.00 USD", got)
+	}
+	if got := b.Get("EUR").M; got != 800 {
+		t.Errorf("expected bag to be unchanged, got %v", got)
+	}
+
+	if _, err := b.Convert("JPY", rates); err != ErrMoneyUnknownRate {
+		t.Errorf("expected error %v, got %v", ErrMoneyUnknownRate, err)
+	}
+
+	total, err = new(MoneyBag).Convert("EUR", rates)
+	if err != nil || total.String() != "0.00 EUR" {
+		t.Errorf("expected %v, got %v (%v)", "0.00 EUR", total, err)
+	}
+}
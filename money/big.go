@@ -0,0 +1,153 @@
+package money
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/hailocab/i18n-go/locale"
+)
+
+// BigMoney is like Money, but its amount is a big.Int, so it cannot
+// overflow, e.g. for amounts of 18 decimal crypto currencies or after
+// hyperinflation that exceed the about 9.2e18 minor units of Money. Use
+// Big and Money to convert between the two.
+type BigMoney struct {
+	// M is the amount in minor units, e.g. cents for USD
+	M *big.Int
+	C string
+	// dp is the decimal factor of M like in Money
+	dp int64
+}
+
+// NewBig returns a new BigMoney with the amount m in the minor unit of the
+// currency c, like New. m is copied.
+func NewBig(m *big.Int, c string) *BigMoney {
+	return &BigMoney{M: new(big.Int).Set(m), C: c, dp: newScale(c)}
+}
+
+// Returns m as a BigMoney with the same amount, currency and decimal
+// places.
+func (m *Money) Big() *BigMoney {
+	return &BigMoney{M: big.NewInt(m.M), C: m.C, dp: m.dp}
+}
+
+// Returns b as a Money with the same amount, currency and decimal places,
+// or ErrMoneyOverflow if the amount does not fit into an int64.
+func (b *BigMoney) Money() (*Money, error) {
+	if !b.M.IsInt64() {
+		return nil, ErrMoneyOverflow
+	}
+	return &Money{M: b.M.Int64(), C: b.C, dp: b.dp}, nil
+}
+
+// Returns the decimal factor of b, e.g. 100 for 2 decimal places.
+func (b *BigMoney) scale() int64 {
+	return (&Money{dp: b.dp}).scale()
+}
+
+// Returns the amounts of b and n with the same decimal factor, which is
+// the finer of the two, along with that factor and the common currency.
+// Panics with ErrCurrencyMismatch if the currencies differ.
+func (b *BigMoney) align(n *BigMoney) (x, y *big.Int, dp int64, c string) {
+	c, err := commonCurrency(&Money{C: b.C}, &Money{C: n.C})
+	if err != nil {
+		panic(err)
+	}
+	x, y, dp = new(big.Int).Set(b.M), new(big.Int).Set(n.M), b.scale()
+	switch {
+	case n.scale() > dp:
+		dp = n.scale()
+		x.Mul(x, big.NewInt(dp/b.scale()))
+	case n.scale() < dp:
+		y.Mul(y, big.NewInt(dp/n.scale()))
+	}
+	return x, y, dp, c
+}
+
+// Sets the amount of b to x with the decimal factor dp and the currency c.
+func (b *BigMoney) set(x *big.Int, dp int64, c string) *BigMoney {
+	if dp != b.scale() {
+		b.dp = dp
+	}
+	b.M, b.C = x, c
+	return b
+}
+
+// Adds n to b. Add modifies and returns b like Money.Add. It panics with
+// ErrCurrencyMismatch if the currencies differ.
+func (b *BigMoney) Add(n *BigMoney) *BigMoney {
+	x, y, dp, c := b.align(n)
+	return b.set(x.Add(x, y), dp, c)
+}
+
+// Subtracts n from b. Sub modifies and returns b like Money.Sub. It
+// panics with ErrCurrencyMismatch if the currencies differ.
+func (b *BigMoney) Sub(n *BigMoney) *BigMoney {
+	x, y, dp, c := b.align(n)
+	return b.set(x.Sub(x, y), dp, c)
+}
+
+// Multiplies b by n. Mul modifies and returns b like Money.Mul: the
+// product is computed exactly and rounded with the package-wide rounding
+// mode to the decimal places of b.
+func (b *BigMoney) Mul(n *BigMoney) *BigMoney {
+	x := new(big.Rat).SetFrac(n.M, big.NewInt(n.scale()))
+	b.M = GetRoundingMode().roundBig(x.Mul(x, new(big.Rat).SetInt(b.M)))
+	return b
+}
+
+// Divides b by n. Div modifies and returns b like Money.Div: the
+// quotient is computed exactly and rounded with the package-wide rounding
+// mode to the decimal places of b. Div panics with ErrMoneyDivideByZero
+// if n is zero.
+func (b *BigMoney) Div(n *BigMoney) *BigMoney {
+	if n.M.Sign() == 0 {
+		panic(ErrMoneyDivideByZero)
+	}
+	x := new(big.Rat).SetFrac(big.NewInt(n.scale()), n.M)
+	b.M = GetRoundingMode().roundBig(x.Mul(x, new(big.Rat).SetInt(b.M)))
+	return b
+}
+
+// Compares b and n like Money.Cmp. Cmp panics with ErrCurrencyMismatch
+// if the currencies differ.
+func (b *BigMoney) Cmp(n *BigMoney) int {
+	x, y, _, _ := b.align(n)
+	return x.Cmp(y)
+}
+
+// Returns the amount and currency of b like Money.String, e.g.
+// "12345678901234567890.12 USD".
+func (b *BigMoney) String() string {
+	whole, frac := b.split()
+	if b.M.Sign() < 0 {
+		whole = "-" + whole
+	}
+	if frac != "" {
+		whole += "." + frac
+	}
+	return whole + " " + b.C
+}
+
+// Formats b like Money.Format with the decimal places of b, e.g.
+// "$12,345,678,901,234,567,890.12" for en_US. If the locale is unknown,
+// String is returned.
+func (b *BigMoney) Format(loc string) string {
+	l := locale.Get(loc)
+	if l == nil {
+		return b.String()
+	}
+	whole, frac := b.split()
+	return newFormatter(l, FormatOptions{}).format(&Money{C: b.C, dp: b.dp}, whole, frac, b.M.Sign())
+}
+
+// Returns the whole number and the decimals of the absolute amount of b.
+func (b *BigMoney) split() (whole, frac string) {
+	q, r := new(big.Int).QuoRem(new(big.Int).Abs(b.M), big.NewInt(b.scale()), new(big.Int))
+	whole = q.String()
+	if digits := decimalPlaces(b.scale()); digits > 0 {
+		frac = r.String()
+		frac = strings.Repeat("0", digits-len(frac)) + frac
+	}
+	return whole, frac
+}
@@ -0,0 +1,130 @@
+package money
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func bigInt(s string) *big.Int {
+	x, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid big.Int " + s)
+	}
+	return x
+}
+
+func TestBigMoneyArithmetic(t *testing.T) {
+	max := NewBig(big.NewInt(math.MaxInt64), "USD")
+	var fixtures = []struct {
+		got      *BigMoney
+		expected string
+	}{
+		{NewBig(big.NewInt(math.MaxInt64), "USD").Add(NewBig(big.NewInt(1), "USD")), "92233720368547758.08 USD"},
+		{NewBig(big.NewInt(math.MaxInt64), "USD").Add(max), "184467440737095516.14 USD"},
+		{NewBig(big.NewInt(math.MinInt64), "USD").Sub(max), "-184467440737095516.15 USD"},
+		{NewBig(big.NewInt(math.MaxInt64), "USD").Mul(NewBig(big.NewInt(1000), "USD")), "922337203685477580.70 USD"},
+		{NewBig(bigInt("1000000000000000000000"), "USD").Div(NewBig(big.NewInt(300), "USD")), "3333333333333333333.33 USD"},
+		{NewBig(big.NewInt(100), "USD").Sub(NewBig(big.NewInt(101), "USD")), "-0.01 USD"},
+		{NewBig(big.NewInt(100), "USD").Add(NewWithScale(1, "USD", 3).Big()), "1.001 USD"},
+		{NewCrypto(math.MaxInt64, "ETH", 18).Big().Add(NewCrypto(math.MaxInt64, "ETH", 18).Big()), "18.446744073709551614 ETH"},
+		{NewBig(big.NewInt(-150), "JPY"), "-150 JPY"},
+	}
+
+	for i, f := range fixtures {
+		if f.got.String() != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, f.got)
+		}
+	}
+
+	if max.String() != "92233720368547758.07 USD" {
+		t.Errorf("expected the operand to be unchanged, got %v", max)
+	}
+}
+
+func TestBigMoneyPanics(t *testing.T) {
+	var fixtures = []struct {
+		f   func()
+		err error
+	}{
+		{func() { NewBig(big.NewInt(1), "USD").Add(NewBig(big.NewInt(1), "EUR")) }, ErrCurrencyMismatch},
+		{func() { NewBig(big.NewInt(1), "USD").Div(NewBig(big.NewInt(0), "USD")) }, ErrMoneyDivideByZero},
+	}
+
+	for i, f := range fixtures {
+		func() {
+			defer func() {
+				if r := recover(); r != f.err {
+					t.Errorf("%d. expected panic with %v, got %v", i, f.err, r)
+				}
+			}()
+			f.f()
+		}()
+	}
+}
+
+func TestBigMoneyConversion(t *testing.T) {
+	var fixtures = []*Money{
+		New(123456, "USD"),
+		New(-1, "JPY"),
+		New(math.MaxInt64, "USD"),
+		New(math.MinInt64, "USD"),
+		NewWithScale(1234567, "USD", 4),
+		NewCrypto(150000000, "BTC", 8),
+		&Money{M: 123, C: "USD"},
+	}
+
+	for i, m := range fixtures {
+		b := m.Big()
+		if b.String() != m.String() {
+			t.Errorf("%d. expected %v, got %v", i, m, b)
+		}
+		got, err := b.Money()
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if *got != *m {
+			t.Errorf("%d. expected %#v after the round trip, got %#v", i, m, got)
+		}
+	}
+
+	b := New(math.MaxInt64, "USD").Big().Add(New(1, "USD").Big())
+	if _, err := b.Money(); err != ErrMoneyOverflow {
+		t.Errorf("expected error %v, got %v", ErrMoneyOverflow, err)
+	}
+	if m, err := b.Sub(New(1, "USD").Big()).Money(); err != nil || m.M != math.MaxInt64 {
+		t.Errorf("expected %v, got %v (%v)", math.MaxInt64, m, err)
+	}
+}
+
+func TestBigMoneyFormat(t *testing.T) {
+	var fixtures = []struct {
+		m        *BigMoney
+		locale   string
+		expected string
+	}{
+		{NewBig(bigInt("1234567890123456789012"), "USD"), "en_US", "$12,345,678,901,234,567,890.12"},
+		{NewBig(bigInt("-1234567890123456789012"), "USD"), "en_US", "($12,345,678,901,234,567,890.12)"},
+		{NewBig(bigInt("1234567890123456789012"), "EUR"), "de_DE", "12.345.678.901.234.567.890,12 €"},
+		{NewBig(bigInt("1234567890123456789012"), "JPY"), "ja_JP", "¥1,234,567,890,123,456,789,012"},
+		{NewBig(big.NewInt(5), "USD"), "en_US", "$0.05"},
+		{NewBig(big.NewInt(0), "USD"), "en_US", "$0.00"},
+		{NewBig(big.NewInt(5), "USD"), "xx_XX", "0.05 USD"},
+		{NewCrypto(math.MaxInt64, "ETH", 18).Big().Add(NewCrypto(math.MaxInt64, "ETH", 18).Big()), "en_US", "ETH 18.446744073709551614"},
+	}
+
+	for i, f := range fixtures {
+		if got := f.m.Format(f.locale); got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+	}
+
+	// Amounts that fit into Money are formatted the same
+	for i, m := range []*Money{New(123456, "USD"), New(-123456, "EUR"), New(1234, "JPY")} {
+		for _, loc := range []string{"en_US", "de_DE", "ja_JP"} {
+			if got := m.Big().Format(loc); got != m.Format(loc) {
+				t.Errorf("%d. expected %q, got %q", i, m.Format(loc), got)
+			}
+		}
+	}
+}
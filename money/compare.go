@@ -0,0 +1,114 @@
+package money
+
+import (
+	"math/big"
+)
+
+// Compares m and n and returns -1 if m < n, 0 if m == n and +1 if m > n.
+// Neither m nor n is modified. Cmp panics with ErrCurrencyMismatch if the
+// currencies of m and n differ (see CmpErr for a non-panicking version).
+func (m *Money) Cmp(n *Money) int {
+	r, err := m.CmpErr(n)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Compares m and n like Cmp, but returns ErrCurrencyMismatch instead of
+// panicking if the currencies of m and n differ. An empty currency
+// matches any other currency.
+func (m *Money) CmpErr(n *Money) (int, error) {
+	if _, err := commonCurrency(m, n); err != nil {
+		return 0, err
+	}
+	return cmpAmounts(m, n), nil
+}
+
+// Compares the amounts of m and n regardless of their currencies.
+func cmpAmounts(m, n *Money) int {
+	if m.scale() != n.scale() {
+		// Compare amounts with different decimal places exactly
+		a := new(big.Int).Mul(big.NewInt(m.M), big.NewInt(n.scale()))
+		b := new(big.Int).Mul(big.NewInt(n.M), big.NewInt(m.scale()))
+		return a.Cmp(b)
+	}
+	switch {
+	case m.M < n.M:
+		return -1
+	case m.M > n.M:
+		return 1
+	}
+	return 0
+}
+
+// Returns true if m and n have the same currency and amount.
+// Equals returns false if the currencies differ.
+func (m *Money) Equals(n *Money) bool {
+	r, err := m.CmpErr(n)
+	return err == nil && r == 0
+}
+
+// Returns true if m and n have the same amount, IGNORING their
+// currencies, e.g. 100.00 USD and 100.00 EUR. Use it only where the
+// currency is tracked separately; use Equals to compare Money. Amounts
+// with different decimal places are compared by value, e.g. 1.00 and
+// 1.000 are equal.
+func (m *Money) EqualsAmount(n *Money) bool {
+	return cmpAmounts(m, n) == 0
+}
+
+// Returns true if m is less than n.
+// LessThan returns false if the currencies differ.
+func (m *Money) LessThan(n *Money) bool {
+	r, err := m.CmpErr(n)
+	return err == nil && r < 0
+}
+
+// Returns true if m is less than or equal to n.
+// LessThanOrEqual returns false if the currencies differ.
+func (m *Money) LessThanOrEqual(n *Money) bool {
+	r, err := m.CmpErr(n)
+	return err == nil && r <= 0
+}
+
+// Returns true if m is greater than n.
+// GreaterThan returns false if the currencies differ.
+func (m *Money) GreaterThan(n *Money) bool {
+	r, err := m.CmpErr(n)
+	return err == nil && r > 0
+}
+
+// Returns true if m is greater than or equal to n.
+// GreaterThanOrEqual returns false if the currencies differ.
+func (m *Money) GreaterThanOrEqual(n *Money) bool {
+	r, err := m.CmpErr(n)
+	return err == nil && r >= 0
+}
+
+// Returns a copy of lo if m is less than lo, of hi if m is greater than
+// hi, and of m otherwise, e.g. to enforce price bounds. Clamp returns
+// ErrCurrencyMismatch if the currencies of m, lo and hi differ and
+// ErrMoneyInvalidRange if lo is greater than hi.
+func (m *Money) Clamp(lo, hi *Money) (*Money, error) {
+	r, err := lo.CmpErr(hi)
+	if err != nil {
+		return nil, err
+	}
+	if r > 0 {
+		return nil, ErrMoneyInvalidRange
+	}
+	if _, err := commonCurrency(m, lo); err != nil {
+		return nil, err
+	}
+	if _, err := commonCurrency(m, hi); err != nil {
+		return nil, err
+	}
+	switch {
+	case cmpAmounts(m, lo) < 0:
+		return lo.withAmount(lo.M), nil
+	case cmpAmounts(m, hi) > 0:
+		return hi.withAmount(hi.M), nil
+	}
+	return m.withAmount(m.M), nil
+}
@@ -0,0 +1,188 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestCmp(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		n        *Money
+		expected int
+	}{
+		{New(0, "EUR"), New(0, "EUR"), 0},
+		{New(123, "EUR"), New(123, "EUR"), 0},
+		{New(-123, "EUR"), New(-123, "EUR"), 0},
+		{New(122, "EUR"), New(123, "EUR"), -1},
+		{New(-123, "EUR"), New(123, "EUR"), -1},
+		{New(-9223372036854775808, "EUR"), New(9223372036854775807, "EUR"), -1},
+		{New(124, "EUR"), New(123, "EUR"), 1},
+		{New(123, "EUR"), New(-123, "EUR"), 1},
+		{New(9223372036854775807, "EUR"), New(-9223372036854775808, "EUR"), 1},
+	}
+
+	for i, f := range fixtures {
+		got := f.m.Cmp(f.n)
+		if got != f.expected {
+			t.Errorf("%d. expected %d, got %d", i, f.expected, got)
+		}
+	}
+}
+
+func TestCmpDoesNotModify(t *testing.T) {
+	m := New(123, "EUR")
+	n := New(456, "EUR")
+	m.Cmp(n)
+	if m.M != 123 || m.C != "EUR" {
+		t.Errorf("expected m to be unchanged, got %v", m)
+	}
+	if n.M != 456 || n.C != "EUR" {
+		t.Errorf("expected n to be unchanged, got %v", n)
+	}
+}
+
+func TestCmpErr(t *testing.T) {
+	m := New(123, "EUR")
+
+	r, err := m.CmpErr(New(100, "EUR"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if r != 1 {
+		t.Errorf("expected %d, got %d", 1, r)
+	}
+
+	_, err = m.CmpErr(New(123, "USD"))
+	if err != ErrCurrencyMismatch {
+		t.Errorf("expected %v, got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestCmpPanicsOnCurrencyMismatch(t *testing.T) {
+	defer func() {
+		if r := recover(); r != ErrCurrencyMismatch {
+			t.Errorf("expected panic with %v, got %v", ErrCurrencyMismatch, r)
+		}
+	}()
+	New(123, "EUR").Cmp(New(123, "USD"))
+}
+
+func TestComparisons(t *testing.T) {
+	var fixtures = []struct {
+		m   *Money
+		n   *Money
+		eq  bool
+		lt  bool
+		lte bool
+		gt  bool
+		gte bool
+	}{
+		{New(123, "EUR"), New(123, "EUR"), true, false, true, false, true},
+		{New(122, "EUR"), New(123, "EUR"), false, true, true, false, false},
+		{New(124, "EUR"), New(123, "EUR"), false, false, false, true, true},
+		{New(-1, "EUR"), New(0, "EUR"), false, true, true, false, false},
+		// Zero and negative zero are the same amount
+		{New(0, "EUR"), New(0, "EUR").Neg(), true, false, true, false, true},
+		// Equal magnitudes with different currencies never compare
+		{New(123, "EUR"), New(123, "USD"), false, false, false, false, false},
+		{New(0, "EUR"), New(0, "USD"), false, false, false, false, false},
+	}
+
+	for i, f := range fixtures {
+		if got := f.m.Equals(f.n); got != f.eq {
+			t.Errorf("%d. expected Equals to be %v, got %v", i, f.eq, got)
+		}
+		if got := f.m.LessThan(f.n); got != f.lt {
+			t.Errorf("%d. expected LessThan to be %v, got %v", i, f.lt, got)
+		}
+		if got := f.m.LessThanOrEqual(f.n); got != f.lte {
+			t.Errorf("%d. expected LessThanOrEqual to be %v, got %v", i, f.lte, got)
+		}
+		if got := f.m.GreaterThan(f.n); got != f.gt {
+			t.Errorf("%d. expected GreaterThan to be %v, got %v", i, f.gt, got)
+		}
+		if got := f.m.GreaterThanOrEqual(f.n); got != f.gte {
+			t.Errorf("%d. expected GreaterThanOrEqual to be %v, got %v", i, f.gte, got)
+		}
+	}
+}
+
+func TestCmpEmptyCurrency(t *testing.T) {
+	r, err := New(100, "USD").CmpErr(New(50, ""))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if r != 1 {
+		t.Errorf("expected %d, got %d", 1, r)
+	}
+	if !New(50, "").Equals(New(50, "USD")) {
+		t.Errorf("expected empty currency to match USD")
+	}
+}
+
+func TestEqualsAmount(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		n        *Money
+		expected bool
+	}{
+		{New(123, "EUR"), New(123, "EUR"), true},
+		{New(123, "USD"), New(123, "EUR"), true},
+		{New(-123, "USD"), New(-123, "GBP"), true},
+		{New(123, "USD"), New(124, "EUR"), false},
+		{New(123, "USD"), New(-123, "USD"), false},
+		{New(123, "USD"), &Money{M: 123}, true},
+		{New(100, "USD"), NewWithScale(1000, "EUR", 3), true},
+		{New(100, "USD"), NewWithScale(100, "EUR", 3), false},
+	}
+
+	for i, f := range fixtures {
+		if got := f.m.EqualsAmount(f.n); got != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, got)
+		}
+		if f.m.C != f.n.C && f.m.C != "" && f.n.C != "" && f.m.Equals(f.n) {
+			t.Errorf("%d. expected Equals to be false for different currencies", i)
+		}
+	}
+}
+
+func TestClamp(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		lo       *Money
+		hi       *Money
+		expected *Money
+		err      error
+	}{
+		{New(500, "USD"), New(1000, "USD"), New(2000, "USD"), New(1000, "USD"), nil},
+		{New(2500, "USD"), New(1000, "USD"), New(2000, "USD"), New(2000, "USD"), nil},
+		{New(1500, "USD"), New(1000, "USD"), New(2000, "USD"), New(1500, "USD"), nil},
+		{New(1000, "USD"), New(1000, "USD"), New(2000, "USD"), New(1000, "USD"), nil},
+		{New(2000, "USD"), New(1000, "USD"), New(2000, "USD"), New(2000, "USD"), nil},
+		{New(-500, "USD"), New(-1000, "USD"), New(1000, "USD"), New(-500, "USD"), nil},
+		{New(-1500, "USD"), New(-1000, "USD"), New(-200, "USD"), New(-1000, "USD"), nil},
+		{New(-100, "USD"), New(-1000, "USD"), New(-200, "USD"), New(-200, "USD"), nil},
+		{New(1000, "USD"), New(500, "USD"), New(500, "USD"), New(500, "USD"), nil},
+		{NewWithScale(15001, "USD", 3), New(1000, "USD"), New(1500, "USD"), New(1500, "USD"), nil},
+		{New(1500, "USD"), New(2000, "USD"), New(1000, "USD"), nil, ErrMoneyInvalidRange},
+		{New(1500, "EUR"), New(1000, "USD"), New(2000, "USD"), nil, ErrCurrencyMismatch},
+		{New(1500, "USD"), New(1000, "USD"), New(2000, "EUR"), nil, ErrCurrencyMismatch},
+	}
+
+	for i, f := range fixtures {
+		orig := *f.m
+		got, err := f.m.Clamp(f.lo, f.hi)
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+		if f.expected != nil && (got == nil || *got != *f.expected) {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, got)
+		}
+		if got != nil && (got == f.m || got == f.lo || got == f.hi) {
+			t.Errorf("%d. expected a copy, got %p", i, got)
+		}
+		if *f.m != orig {
+			t.Errorf("%d. expected m to be unchanged, got %v", i, f.m)
+		}
+	}
+}
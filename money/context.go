@@ -0,0 +1,31 @@
+package money
+
+import (
+	"context"
+)
+
+type localeKey struct{}
+
+// Returns a copy of ctx that carries the locale loc, e.g. the locale of
+// the request in an HTTP server (see FormatCtx).
+func WithLocale(ctx context.Context, loc string) context.Context {
+	return context.WithValue(ctx, localeKey{}, loc)
+}
+
+// Returns the locale carried by ctx (see WithLocale) and whether there
+// is one.
+func LocaleFromContext(ctx context.Context) (string, bool) {
+	loc, ok := ctx.Value(localeKey{}).(string)
+	return loc, ok
+}
+
+// Formats m like Format with the locale carried by ctx (see WithLocale),
+// or the package-wide default locale if there is none (see
+// SetDefaultLocale).
+func (m *Money) FormatCtx(ctx context.Context) string {
+	loc, ok := LocaleFromContext(ctx)
+	if !ok {
+		loc = GetDefaultLocale()
+	}
+	return m.Format(loc)
+}
@@ -0,0 +1,44 @@
+package money
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFormatCtx(t *testing.T) {
+	var fixtures = []struct {
+		ctx      context.Context
+		expected string
+	}{
+		{WithLocale(context.Background(), "de_DE"), "1.234,56 $"},
+		{WithLocale(context.Background(), "en-us"), "$1,234.56"},
+		{WithLocale(WithLocale(context.Background(), "de_DE"), "en_US"), "$1,234.56"},
+		{context.Background(), "$1,234.56"},
+		{WithLocale(context.Background(), "xx_XX"), "1234.56 USD"},
+	}
+
+	m := New(123456, "USD")
+	for i, f := range fixtures {
+		if got := m.FormatCtx(f.ctx); got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+	}
+}
+
+func TestLocaleFromContext(t *testing.T) {
+	if loc, ok := LocaleFromContext(WithLocale(context.Background(), "de_DE")); !ok || loc != "de_DE" {
+		t.Errorf("expected %v, got %v (%v)", "de_DE", loc, ok)
+	}
+	if loc, ok := LocaleFromContext(context.Background()); ok {
+		t.Errorf("expected no locale, got %v", loc)
+	}
+}
+
+func TestFormatCtxDefaultLocale(t *testing.T) {
+	SetDefaultLocale("de_DE")
+	defer SetDefaultLocale("")
+
+	if got := New(123456, "USD").FormatCtx(context.Background()); got != "1.234,56 $" {
+		t.Errorf("expected %q, got %q", "1.234,56 $", got)
+	}
+}
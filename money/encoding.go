@@ -0,0 +1,162 @@
+package money
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/hailocab/i18n-go/currency"
+	"strings"
+)
+
+// The version of the gob encoding of Money.
+const gobVersion byte = 1
+
+type moneyJSON struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// Returns the number of decimal places m is serialized with: the decimal
+// digits of its currency, or those of m if the currency is unknown.
+func (m *Money) serializedDigits() int {
+	if c := currency.Get(m.C); c != nil {
+		return c.DecimalDigits
+	}
+	return decimalPlaces(m.scale())
+}
+
+// Implements json.Marshaler. Money is serialized as an object like
+// {"amount":"1234.56","currency":"USD"}, where the amount has the decimal
+// digits of the currency (e.g. none for JPY).
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{
+		Amount:   m.Decimal(),
+		Currency: m.C,
+	})
+}
+
+// Implements json.Unmarshaler. See MarshalJSON for the format. The amount
+// is parsed exactly, without going through a float64, and m gets the
+// decimal places of the currency (see New). Like for other types, null
+// leaves m unchanged.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	x, err := parseMoney(v.Amount, v.Currency)
+	if err != nil {
+		return err
+	}
+	*m = *x
+	return nil
+}
+
+// Implements encoding.TextMarshaler. Money is serialized as text like
+// "1234.56 USD", where the amount has the decimal digits of the currency
+// like in MarshalJSON. Money without a currency is serialized as just
+// the amount.
+func (m Money) MarshalText() ([]byte, error) {
+	s := m.Decimal()
+	if m.C != "" {
+		s += " " + m.C
+	}
+	return []byte(s), nil
+}
+
+// Implements encoding.TextUnmarshaler. See MarshalText for the format.
+// The amount is parsed exactly like in UnmarshalJSON.
+func (m *Money) UnmarshalText(text []byte) error {
+	fields := strings.Fields(string(text))
+	var amount, c string
+	switch len(fields) {
+	case 1:
+		amount = fields[0]
+	case 2:
+		amount, c = fields[0], fields[1]
+	default:
+		return fmt.Errorf("i18n: money cannot parse %q", text)
+	}
+	x, err := parseMoney(amount, c)
+	if err != nil {
+		return err
+	}
+	*m = *x
+	return nil
+}
+
+type moneyXML struct {
+	Currency string `xml:"currency,attr"`
+	Amount   string `xml:",chardata"`
+}
+
+// Implements xml.Marshaler. Money is serialized as an element like
+// <amount currency="USD">1234.56</amount>, where the amount has the
+// decimal digits of the currency like in MarshalJSON. The name of the
+// element is that of the field or type being encoded.
+func (m Money) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(moneyXML{
+		Currency: m.C,
+		Amount:   m.Decimal(),
+	}, start)
+}
+
+// Implements xml.Unmarshaler. See MarshalXML for the format. The amount
+// is parsed exactly like in UnmarshalJSON. The currency attribute is
+// required.
+func (m *Money) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v moneyXML
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	amount := strings.TrimSpace(v.Amount)
+	if v.Currency == "" {
+		return fmt.Errorf("i18n: money cannot parse %q: missing currency", amount)
+	}
+	x, err := parseMoney(amount, v.Currency)
+	if err != nil {
+		return err
+	}
+	*m = *x
+	return nil
+}
+
+// Implements gob.GobEncoder. Money is encoded in a compact binary layout
+// that does not depend on the fields of Money:
+//
+//	1 byte   version, currently 1
+//	varint   amount M in minor units (see encoding/binary.PutVarint)
+//	1 byte   decimal places of m plus 1, or 0 for the package-wide DP
+//	n bytes  currency code
+func (m Money) GobEncode() ([]byte, error) {
+	buf := make([]byte, 2+binary.MaxVarintLen64+len(m.C))
+	buf[0] = gobVersion
+	n := 1 + binary.PutVarint(buf[1:], m.M)
+	if m.dp > 0 {
+		buf[n] = byte(decimalPlaces(m.dp) + 1)
+	}
+	n++
+	n += copy(buf[n:], m.C)
+	return buf[:n], nil
+}
+
+// Implements gob.GobDecoder. See GobEncode for the format. Returns
+// ErrMoneyInvalidEncoding if data is not in that format.
+func (m *Money) GobDecode(data []byte) error {
+	if len(data) == 0 || data[0] != gobVersion {
+		return ErrMoneyInvalidEncoding
+	}
+	x, n := binary.Varint(data[1:])
+	if n <= 0 || len(data) < 2+n || int(data[1+n]) > MAXDEC+1 {
+		return ErrMoneyInvalidEncoding
+	}
+	*m = Money{M: x, C: string(data[2+n:])}
+	if digits := int(data[1+n]); digits > 0 {
+		m.dp = int64(newDecimal(digits - 1))
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+package money
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		expected string
+	}{
+		{New(0, "USD"), `{"amount":"0.00","currency":"USD"}`},
+		{New(123456, "USD"), `{"amount":"1234.56","currency":"USD"}`},
+		{New(-123456, "USD"), `{"amount":"-1234.56","currency":"USD"}`},
+		{New(-1, "USD"), `{"amount":"-0.01","currency":"USD"}`},
+		{New(1234, "JPY"), `{"amount":"1234","currency":"JPY"}`},
+		{NewWithScale(123400, "JPY", 2), `{"amount":"1234","currency":"JPY"}`},
+		{NewWithScale(123456, "JPY", 2), `{"amount":"1234.56","currency":"JPY"}`},
+		{New(123450, "BHD"), `{"amount":"123.450","currency":"BHD"}`},
+		{New(123456, "XYZ"), `{"amount":"1234.56","currency":"XYZ"}`},
+		{NewWithScale(1234567, "USD", 3), `{"amount":"1234.567","currency":"USD"}`},
+	}
+
+	for i, f := range fixtures {
+		got, err := json.Marshal(f.m)
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if string(got) != f.expected {
+			t.Errorf("%d. expected %s, got %s", i, f.expected, got)
+		}
+
+		var m Money
+		if err := json.Unmarshal(got, &m); err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if !m.Equals(f.m) || m.C != f.m.C {
+			t.Errorf("%d. expected %v, got %v", i, f.m, &m)
+		}
+	}
+}
+
+func TestMarshalJSONEmbedded(t *testing.T) {
+	v := struct {
+		Price Money `json:"price"`
+	}{Money{M: 123456, C: "USD"}}
+
+	got, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := `{"price":{"amount":"1234.56","currency":"USD"}}`
+	if string(got) != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestUnmarshalJSONInvalid(t *testing.T) {
+	var fixtures = []string{
+		`{"amount":1234.56,"currency":"USD"}`,
+		`{"amount":"1,234.56","currency":"USD"}`,
+		`{"amount":"1234.5.6","currency":"USD"}`,
+		`{"currency":"USD"}`,
+	}
+
+	for i, f := range fixtures {
+		var m Money
+		if err := json.Unmarshal([]byte(f), &m); err == nil {
+			t.Errorf("%d. expected error unmarshaling %s, got %v", i, f, &m)
+		}
+	}
+}
+
+func TestUnmarshalJSONNull(t *testing.T) {
+	m := New(1234, "USD")
+	if err := json.Unmarshal([]byte("null"), m); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if m.M != 1234 || m.C != "USD" {
+		t.Errorf("expected %v, got %v", New(1234, "USD"), m)
+	}
+
+	var v struct{ Price *Money }
+	if err := json.Unmarshal([]byte(`{"Price":null}`), &v); err != nil || v.Price != nil {
+		t.Errorf("expected nil price, got %v, %v", v.Price, err)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	const expected = `i18n: money cannot parse "1234.5.6": `
+	var m Money
+	errs := []error{
+		json.Unmarshal([]byte(`{"amount":"1234.5.6","currency":"USD"}`), &m),
+		m.UnmarshalText([]byte("1234.5.6 USD")),
+		xml.Unmarshal([]byte(`<amount currency="USD">1234.5.6</amount>`), &m),
+	}
+
+	for i, err := range errs {
+		if err == nil || !strings.HasPrefix(err.Error(), expected) {
+			t.Errorf("%d. expected error %s..., got %v", i, expected, err)
+		}
+	}
+}
+
+func TestMarshalText(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		expected string
+	}{
+		{New(0, "USD"), "0.00 USD"},
+		{New(123456, "USD"), "1234.56 USD"},
+		{New(-123456, "USD"), "-1234.56 USD"},
+		{New(1234, "JPY"), "1234 JPY"},
+		{New(-1234, "JPY"), "-1234 JPY"},
+		{New(123450, "BHD"), "123.450 BHD"},
+		{NewWithScale(1234567, "USD", 3), "1234.567 USD"},
+		{&Money{M: 123456}, "1234.56"},
+	}
+
+	for i, f := range fixtures {
+		got, err := f.m.MarshalText()
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if string(got) != f.expected {
+			t.Errorf("%d. expected %s, got %s", i, f.expected, got)
+		}
+
+		var m Money
+		if err := m.UnmarshalText(got); err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if !m.Equals(f.m) || m.C != f.m.C {
+			t.Errorf("%d. expected %v, got %v", i, f.m, &m)
+		}
+	}
+}
+
+func TestUnmarshalTextInvalid(t *testing.T) {
+	var fixtures = []string{
+		"",
+		"USD",
+		"12.34.56 USD",
+		"1234.56 USD extra",
+		"1e3 USD",
+		"99999999999999999999 USD",
+	}
+
+	for i, f := range fixtures {
+		var m Money
+		if err := m.UnmarshalText([]byte(f)); err == nil {
+			t.Errorf("%d. expected error for %q, got %v", i, f, &m)
+		}
+	}
+}
+
+func TestTextMarshalerMapKey(t *testing.T) {
+	data, err := json.Marshal(map[Money]int{*New(150, "EUR"): 1})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if string(data) != `{"1.50 EUR":1}` {
+		t.Errorf("expected %s, got %s", `{"1.50 EUR":1}`, data)
+	}
+}
+
+func TestGob(t *testing.T) {
+	var fixtures = []*Money{
+		New(0, "USD"),
+		New(123456, "USD"),
+		New(-123456, "USD"),
+		New(math.MaxInt64, "EUR"),
+		New(math.MinInt64, "EUR"),
+		New(1234, "JPY"),
+		New(123450, "BHD"),
+		NewWithScale(1234567, "USD", 3),
+		NewWithScale(1234, "USD", 0),
+		&Money{M: 123456, C: "USD"},
+		&Money{M: 1},
+	}
+
+	for i, f := range fixtures {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(f); err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		var m Money
+		if err := gob.NewDecoder(&buf).Decode(&m); err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if m != *f {
+			t.Errorf("%d. expected %#v, got %#v", i, *f, m)
+		}
+	}
+}
+
+func TestGobEncode(t *testing.T) {
+	data, err := New(-123456, "USD").GobEncode()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := []byte{1, 0xff, 0x88, 0x0f, 3, 'U', 'S', 'D'}
+	if !bytes.Equal(data, expected) {
+		t.Errorf("expected %v, got %v", expected, data)
+	}
+}
+
+func TestGobDecodeInvalid(t *testing.T) {
+	var fixtures = [][]byte{
+		nil,
+		{2, 0, 0},
+		{1},
+		{1, 0x80},
+		{1, 2},
+		{1, 2, 20, 'U', 'S', 'D'},
+	}
+
+	for i, f := range fixtures {
+		var m Money
+		if err := m.GobDecode(f); err != ErrMoneyInvalidEncoding {
+			t.Errorf("%d. expected error %v, got %v", i, ErrMoneyInvalidEncoding, err)
+		}
+	}
+}
+
+func TestMarshalXML(t *testing.T) {
+	type invoice struct {
+		XMLName xml.Name `xml:"invoice"`
+		Amount  *Money   `xml:"amount"`
+	}
+
+	var fixtures = []struct {
+		m        *Money
+		expected string
+	}{
+		{New(123456, "USD"), `<invoice><amount currency="USD">1234.56</amount></invoice>`},
+		{New(-123456, "USD"), `<invoice><amount currency="USD">-1234.56</amount></invoice>`},
+		{New(0, "EUR"), `<invoice><amount currency="EUR">0.00</amount></invoice>`},
+		{New(1234, "JPY"), `<invoice><amount currency="JPY">1234</amount></invoice>`},
+		{New(-123450, "BHD"), `<invoice><amount currency="BHD">-123.450</amount></invoice>`},
+		{NewWithScale(1234567, "USD", 3), `<invoice><amount currency="USD">1234.567</amount></invoice>`},
+	}
+
+	for i, f := range fixtures {
+		got, err := xml.Marshal(invoice{Amount: f.m})
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if string(got) != f.expected {
+			t.Errorf("%d. expected %s, got %s", i, f.expected, got)
+		}
+
+		var v invoice
+		if err := xml.Unmarshal(got, &v); err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if !v.Amount.Equals(f.m) || v.Amount.C != f.m.C {
+			t.Errorf("%d. expected %v, got %v", i, f.m, v.Amount)
+		}
+	}
+}
+
+func TestUnmarshalXML(t *testing.T) {
+	var fixtures = []struct {
+		data     string
+		expected *Money
+		valid    bool
+	}{
+		{`<amount currency="USD"> 12.50 </amount>`, New(1250, "USD"), true},
+		{`<amount currency="JPY">-12</amount>`, New(-12, "JPY"), true},
+		{`<amount>12.50</amount>`, nil, false},
+		{`<amount currency="">12.50</amount>`, nil, false},
+		{`<amount currency="USD">12,50</amount>`, nil, false},
+		{`<amount currency="USD"></amount>`, nil, false},
+	}
+
+	for i, f := range fixtures {
+		var m Money
+		err := xml.Unmarshal([]byte(f.data), &m)
+		if f.valid && err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if !f.valid && err == nil {
+			t.Errorf("%d. expected error, got %v", i, &m)
+		}
+		if f.valid && (!m.Equals(f.expected) || m.C != f.expected.C) {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, &m)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package money
+
+import (
+	"github.com/hailocab/i18n-go/currency"
+	"math/big"
+)
+
+// Rate is an exchange rate: one unit of currency From is worth Ratio
+// units of currency To.
+type Rate struct {
+	From  string
+	To    string
+	Ratio float64
+}
+
+// Converts m into another currency with the given exchange rate. The
+// result has the decimal places of the target currency (or those of m
+// if the target currency is unknown) and is rounded with the package-wide
+// rounding mode. The conversion is computed exactly with the ratio taken
+// as the shortest decimal that converts to it (see NewFromFloat), without
+// float64 drift on large amounts. m is left unchanged. Convert returns
+// ErrCurrencyMismatch if the rate does not convert from the currency of
+// m, and ErrMoneyInvalidRate if the ratio is negative, NaN or infinite.
+func (m *Money) Convert(rate Rate) (*Money, error) {
+	if rate.From != m.C {
+		return nil, ErrCurrencyMismatch
+	}
+	dp := m.scale()
+	if c := currency.Get(rate.To); c != nil {
+		dp = int64(newDecimal(c.DecimalDigits))
+	}
+	return m.convert(rate.Ratio, rate.To, dp)
+}
+
+// Converts m into currency to with the ratio, rounded to the decimal
+// factor dp.
+func (m *Money) convert(ratio float64, to string, dp int64) (*Money, error) {
+	if ratio < 0 {
+		return nil, ErrMoneyInvalidRate
+	}
+	r, err := decimalRat(ratio)
+	if err != nil {
+		return nil, ErrMoneyInvalidRate
+	}
+
+	// x = M / scale(m) * ratio * dp
+	x := new(big.Rat).SetFrac(big.NewInt(m.M), big.NewInt(m.scale()))
+	x.Mul(x, r)
+	x.Mul(x, new(big.Rat).SetInt64(dp))
+	v, err := GetRoundingMode().roundRat(x)
+	if err != nil {
+		return nil, err
+	}
+	return &Money{M: v, C: to, dp: dp}, nil
+}
+
+// RateProvider provides exchange rates, e.g. from a static table or
+// a live API. Rate returns how many units of currency to one unit of
+// currency from is worth.
+type RateProvider interface {
+	Rate(from, to string) (float64, error)
+}
+
+// Converts m into currency to with an exchange rate from p (see Convert).
+func (m *Money) ConvertVia(to string, p RateProvider) (*Money, error) {
+	result, _, err := m.ConvertAudited(to, p)
+	return result, err
+}
+
+// Converts m into currency to like ConvertVia, but also returns the
+// exchange rate that was applied, e.g. to record it in an audit trail.
+// The rate is requested from p only once, so it is always the one the
+// result was computed with.
+func (m *Money) ConvertAudited(to string, p RateProvider) (result *Money, rateUsed float64, err error) {
+	ratio, err := p.Rate(m.C, to)
+	if err != nil {
+		return nil, 0, err
+	}
+	result, err = m.Convert(Rate{From: m.C, To: to, Ratio: ratio})
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, ratio, nil
+}
+
+// Adds n to m and returns the sum as a new Money in the currency of m,
+// leaving m and n unchanged. If n has another currency, it is first
+// converted into the currency of m with an exchange rate from p and
+// rounded to the decimal places of m, e.g. 100.00 USD plus 50.00 EUR is
+// 155.00 USD at a rate of 1.1. Returns the errors of p and Convert, and
+// ErrMoneyOverflow if the sum overflows.
+func (m *Money) AddConvert(n *Money, p RateProvider) (*Money, error) {
+	if n.C != m.C && n.C != "" && m.C != "" {
+		ratio, err := p.Rate(n.C, m.C)
+		if err != nil {
+			return nil, err
+		}
+		if n, err = n.convert(ratio, m.C, m.scale()); err != nil {
+			return nil, err
+		}
+	}
+	return m.withAmount(m.M).AddErr(n)
+}
+
+// RatePair identifies the currencies of an exchange rate.
+type RatePair struct {
+	From string
+	To   string
+}
+
+// StaticRates is a RateProvider backed by a map of exchange rates.
+// If a rate is missing, the inverse of the reverse rate is used.
+type StaticRates map[RatePair]float64
+
+// NewStaticRates returns a StaticRates with the given rates.
+func NewStaticRates(rates ...Rate) StaticRates {
+	s := make(StaticRates)
+	for _, r := range rates {
+		s[RatePair{r.From, r.To}] = r.Ratio
+	}
+	return s
+}
+
+// Implements RateProvider. Rate returns ErrMoneyUnknownRate if neither
+// the rate nor its reverse is in the map.
+func (s StaticRates) Rate(from, to string) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+	if r, found := s[RatePair{from, to}]; found {
+		return r, nil
+	}
+	if r, found := s[RatePair{to, from}]; found && r != 0 {
+		return 1 / r, nil
+	}
+	return 0, ErrMoneyUnknownRate
+}
+
+type crossRates struct {
+	p    RateProvider
+	base string
+}
+
+// CrossRates returns a RateProvider that derives rates between any two
+// currencies by going through a base currency, e.g. EUR to GBP as
+// EUR to USD times USD to GBP, for providers that only know rates
+// against the base currency.
+func CrossRates(p RateProvider, base string) RateProvider {
+	return &crossRates{p, base}
+}
+
+func (c *crossRates) Rate(from, to string) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+	if from == c.base || to == c.base {
+		return c.p.Rate(from, to)
+	}
+	r1, err := c.p.Rate(from, c.base)
+	if err != nil {
+		return 0, err
+	}
+	r2, err := c.p.Rate(c.base, to)
+	if err != nil {
+		return 0, err
+	}
+	return r1 * r2, nil
+}
@@ -0,0 +1,220 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		rate     Rate
+		expected int64
+		digits   int
+	}{
+		{New(10000, "USD"), Rate{"USD", "EUR", 0.9}, 9000, 2},
+		{New(-10000, "USD"), Rate{"USD", "EUR", 0.9}, -9000, 2},
+		{New(12345, "USD"), Rate{"USD", "EUR", 0.5}, 6173, 2},
+		{New(10000, "USD"), Rate{"USD", "JPY", 149.123}, 14912, 0},
+		{New(10000, "USD"), Rate{"USD", "BHD", 0.376}, 37600, 3},
+		{New(10000, "USD"), Rate{"USD", "XYZ", 2}, 20000, 2},
+		{NewWithScale(1500, "JPY", 0), Rate{"JPY", "USD", 0.0067}, 1005, 2},
+		// Large amounts do not drift like they would in float64
+		{New(900000000000000001, "USD"), Rate{"USD", "EUR", 1}, 900000000000000001, 2},
+	}
+
+	for i, f := range fixtures {
+		got, err := f.m.Convert(f.rate)
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if got.M != f.expected {
+			t.Errorf("%d. expected money amount to be %v, got %v", i, f.expected, got.M)
+		}
+		if got.C != f.rate.To {
+			t.Errorf("%d. expected currency to be %v, got %v", i, f.rate.To, got.C)
+		}
+		if got.scale() != int64(newDecimal(f.digits)) {
+			t.Errorf("%d. expected %d decimal places, got %d", i, f.digits, decimalPlaces(got.scale()))
+		}
+	}
+}
+
+func TestConvertInvalid(t *testing.T) {
+	var fixtures = []struct {
+		rate Rate
+		err  error
+	}{
+		{Rate{"EUR", "GBP", 0.9}, ErrCurrencyMismatch},
+		{Rate{"USD", "EUR", -1}, ErrMoneyInvalidRate},
+		{Rate{"USD", "EUR", math.NaN()}, ErrMoneyInvalidRate},
+		{Rate{"USD", "EUR", math.Inf(1)}, ErrMoneyInvalidRate},
+		{Rate{"USD", "EUR", 1e10}, ErrMoneyOverflow},
+	}
+
+	for i, f := range fixtures {
+		_, err := New(math.MaxInt64/100, "USD").Convert(f.rate)
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+	}
+}
+
+func TestStaticRates(t *testing.T) {
+	rates := NewStaticRates(
+		Rate{"USD", "EUR", 0.8},
+		Rate{"USD", "GBP", 0.5},
+	)
+
+	var fixtures = []struct {
+		from, to string
+		expected float64
+		err      error
+	}{
+		{"USD", "EUR", 0.8, nil},
+		{"EUR", "USD", 1.25, nil},
+		{"GBP", "USD", 2, nil},
+		{"EUR", "EUR", 1, nil},
+		{"EUR", "GBP", 0, ErrMoneyUnknownRate},
+	}
+
+	for i, f := range fixtures {
+		got, err := rates.Rate(f.from, f.to)
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+		if got != f.expected {
+			t.Errorf("%d. expected rate to be %v, got %v", i, f.expected, got)
+		}
+	}
+}
+
+func TestConvertVia(t *testing.T) {
+	rates := NewStaticRates(
+		Rate{"USD", "EUR", 0.8},
+		Rate{"USD", "GBP", 0.5},
+	)
+
+	got, err := New(10000, "USD").ConvertVia("EUR", rates)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.M != 8000 || got.C != "EUR" {
+		t.Errorf("expected %v, got %v", "80.00 EUR", got)
+	}
+
+	if _, err := New(10000, "EUR").ConvertVia("GBP", rates); err != ErrMoneyUnknownRate {
+		t.Errorf("expected error %v, got %v", ErrMoneyUnknownRate, err)
+	}
+
+	// EUR -> USD -> GBP
+	got, err = New(10000, "EUR").ConvertVia("GBP", CrossRates(rates, "USD"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.M != 6250 || got.C != "GBP" {
+		t.Errorf("expected %v, got %v", "62.50 GBP", got)
+	}
+}
+
+// A RateProvider that returns a different rate on every call.
+type driftingRates struct {
+	calls int
+}
+
+func (d *driftingRates) Rate(from, to string) (float64, error) {
+	d.calls++
+	return 0.8 + float64(d.calls)/100, nil
+}
+
+func TestConvertAudited(t *testing.T) {
+	rates := NewStaticRates(
+		Rate{"USD", "EUR", 0.8},
+		Rate{"USD", "JPY", 149.5},
+	)
+
+	var fixtures = []struct {
+		m        *Money
+		to       string
+		expected int64
+		rate     float64
+		err      error
+	}{
+		{New(10000, "USD"), "EUR", 8000, 0.8, nil},
+		{New(10000, "EUR"), "USD", 12500, 1.25, nil},
+		{New(10000, "USD"), "JPY", 14950, 149.5, nil},
+		{New(10000, "USD"), "USD", 10000, 1, nil},
+		{New(10000, "EUR"), "JPY", 0, 0, ErrMoneyUnknownRate},
+	}
+
+	for i, f := range fixtures {
+		got, rate, err := f.m.ConvertAudited(f.to, rates)
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+			continue
+		}
+		if rate != f.rate {
+			t.Errorf("%d. expected rate %v, got %v", i, f.rate, rate)
+		}
+		if err != nil {
+			continue
+		}
+		if got.M != f.expected || got.C != f.to {
+			t.Errorf("%d. expected %v %v, got %v", i, f.expected, f.to, got)
+		}
+		// The result is the one Convert computes with the returned rate
+		if c, _ := f.m.Convert(Rate{f.m.C, f.to, rate}); !c.Equals(got) {
+			t.Errorf("%d. expected %v for rate %v, got %v", i, c, rate, got)
+		}
+	}
+
+	p := &driftingRates{}
+	got, rate, err := New(10000, "USD").ConvertAudited("EUR", p)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if p.calls != 1 {
+		t.Errorf("expected 1 call to the provider, got %d", p.calls)
+	}
+	if rate != 0.81 || got.M != 8100 {
+		t.Errorf("expected %v at rate %v, got %v at rate %v", "81.00 EUR", 0.81, got, rate)
+	}
+}
+
+func TestAddConvert(t *testing.T) {
+	rates := NewStaticRates(
+		Rate{"USD", "EUR", 0.8},
+		Rate{"USD", "JPY", 149.5},
+	)
+
+	var fixtures = []struct {
+		m        *Money
+		n        *Money
+		expected string
+		err      error
+	}{
+		{New(10000, "USD"), New(5000, "EUR"), "162.50 USD", nil},
+		{New(10000, "USD"), New(1, "EUR"), "100.01 USD", nil},
+		{New(10000, "USD"), New(-8000, "EUR"), "0.00 USD", nil},
+		{New(10000, "USD"), New(2500, "USD"), "125.00 USD", nil},
+		{NewWithScale(1000000, "USD", 4), New(1, "EUR"), "100.0125 USD", nil},
+		{New(8000, "EUR"), New(10000, "USD"), "160.00 EUR", nil},
+		{New(10000, "USD"), New(5000, "GBP"), "", ErrMoneyUnknownRate},
+		{New(9223372036854775807, "USD"), New(100, "EUR"), "", ErrMoneyOverflow},
+	}
+
+	for i, f := range fixtures {
+		m, n := f.m.Copy(), f.n.Copy()
+		got, err := f.m.AddConvert(f.n, rates)
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+			continue
+		}
+		if err == nil && got.String() != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, got)
+		}
+		if !f.m.Equals(m) || !f.n.Equals(n) {
+			t.Errorf("%d. expected %v and %v to be unchanged, got %v and %v", i, m, n, f.m, f.n)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FlagMoney wraps Money to implement the flag.Value interface (Money
+// itself can not implement flag.Value because of its Set method), e.g.
+//
+//	var budget FlagMoney
+//	flag.Var(&budget, "budget", "budget like 49.99USD")
+//
+// The flag value is an amount followed by a 3-letter currency code,
+// optionally separated by a space, e.g. "49.99USD" or "49.99 USD".
+type FlagMoney struct {
+	Money
+}
+
+// Implements flag.Value. Returns the amount and currency like
+// MarshalText, e.g. "49.99 USD".
+func (f *FlagMoney) String() string {
+	text, _ := f.MarshalText()
+	return string(text)
+}
+
+// Implements flag.Value. The amount is parsed exactly and may have at
+// most the decimal digits of the currency (see NewFromString).
+func (f *FlagMoney) Set(s string) error {
+	s = strings.TrimSpace(s)
+	if len(s) < 4 {
+		return fmt.Errorf("i18n: money cannot parse %q", s)
+	}
+	amount, c := strings.TrimSpace(s[:len(s)-3]), s[len(s)-3:]
+	for _, r := range c {
+		if r < 'A' || r > 'Z' {
+			return fmt.Errorf("i18n: money cannot parse %q: invalid currency code", s)
+		}
+	}
+	m, err := NewFromString(amount, c)
+	if err != nil {
+		return err
+	}
+	f.Money = *m
+	return nil
+}
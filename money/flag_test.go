@@ -0,0 +1,47 @@
+package money
+
+import (
+	"flag"
+	"io/ioutil"
+	"testing"
+)
+
+func TestFlagMoney(t *testing.T) {
+	var fixtures = []struct {
+		arg      string
+		expected string
+		valid    bool
+	}{
+		{"49.99USD", "49.99 USD", true},
+		{"49.99 USD", "49.99 USD", true},
+		{"-5EUR", "-5.00 EUR", true},
+		{"1000JPY", "1000 JPY", true},
+		{"1.250BHD", "1.250 BHD", true},
+		{"49.999USD", "", false},
+		{"10.5JPY", "", false},
+		{"49.99", "", false},
+		{"49.99usd", "", false},
+		{"USD", "", false},
+		{"49.99US1", "", false},
+		{"abcUSD", "", false},
+		{"", "", false},
+	}
+
+	for i, f := range fixtures {
+		var m FlagMoney
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(ioutil.Discard)
+		fs.Var(&m, "budget", "budget")
+
+		err := fs.Parse([]string{"-budget=" + f.arg})
+		if f.valid && err != nil {
+			t.Errorf("%d. expected no error, got %v", i, err)
+		}
+		if !f.valid && err == nil {
+			t.Errorf("%d. expected error for %q, got %v", i, f.arg, m.String())
+		}
+		if f.valid && m.String() != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, m.String())
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FmtMoney wraps Money to implement the fmt.Formatter interface (Money
+// itself can not implement fmt.Formatter because of its Format method),
+// e.g.
+//
+//	fmt.Printf("%.0v", FmtMoney{*m}) // 1235 USD
+//
+// The supported verbs are:
+//
+//	%v, %s  the amount and currency like String, e.g. 1234.56 USD
+//	%f      the amount only, e.g. 1234.56
+//
+// The precision sets the number of decimal places, rounding with the
+// package-wide rounding mode. The "+" flag adds a plus sign to amounts
+// greater than zero. The width pads the result with spaces, on the left
+// unless the "-" flag is given.
+type FmtMoney struct {
+	Money
+}
+
+// Implements fmt.Formatter.
+func (f FmtMoney) Format(s fmt.State, verb rune) {
+	m := &f.Money
+	value, dp, digits := m.M, m.scale(), decimalPlaces(m.scale())
+	// Fewer decimal places round the amount, more are padded with zeros
+	if p, ok := s.Precision(); ok {
+		if p < digits {
+			value, dp = roundDigits(value, dp, p), int64(newDecimal(p))
+		}
+		digits = p
+	}
+
+	str := formatDecimal(value, dp, digits)
+	if s.Flag('+') && value > 0 {
+		str = "+" + str
+	}
+	switch verb {
+	case 'v', 's':
+		if m.C != "" {
+			str += " " + m.C
+		}
+	case 'f':
+	default:
+		fmt.Fprintf(s, "%%!%c(money.FmtMoney=%s)", verb, m.String())
+		return
+	}
+
+	if w, ok := s.Width(); ok && w > len([]rune(str)) {
+		pad := strings.Repeat(" ", w-len([]rune(str)))
+		if s.Flag('-') {
+			str += pad
+		} else {
+			str = pad + str
+		}
+	}
+	fmt.Fprint(s, str)
+}
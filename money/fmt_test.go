@@ -0,0 +1,41 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestFmtMoney(t *testing.T) {
+	var fixtures = []struct {
+		format   string
+		m        *Money
+		expected string
+	}{
+		{"%v", New(123456, "USD"), "1234.56 USD"},
+		{"%s", New(-123456, "USD"), "-1234.56 USD"},
+		{"%f", New(123456, "USD"), "1234.56"},
+		{"%.0v", New(123456, "USD"), "1235 USD"},
+		{"%.1f", New(123444, "USD"), "1234.4"},
+		{"%.3v", New(123456, "USD"), "1234.560 USD"},
+		{"%.2v", New(1000, "JPY"), "1000.00 JPY"},
+		{"%+v", New(500, "EUR"), "+5.00 EUR"},
+		{"%+v", New(-500, "EUR"), "-5.00 EUR"},
+		{"%+v", New(0, "EUR"), "0.00 EUR"},
+		{"%+.0f", New(40, "EUR"), "0"},
+		{"%12v", New(500, "EUR"), "    5.00 EUR"},
+		{"%-12v|", New(500, "EUR"), "5.00 EUR    |"},
+		{"%4v", New(500, "EUR"), "5.00 EUR"},
+		{"%v", New(123450, "BHD"), "123.450 BHD"},
+		{"%d", New(500, "EUR"), "%!d(money.FmtMoney=5.00 EUR)"},
+		{"%.4f", New(1e18, "USD"), "10000000000000000.0000"},
+		{"%.4v", New(math.MinInt64, "USD"), "-92233720368547758.0800 USD"},
+		{"%.20v", New(123, "USD"), "1.23000000000000000000 USD"},
+	}
+
+	for i, f := range fixtures {
+		if got := fmt.Sprintf(f.format, FmtMoney{*f.m}); got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package money
+
+import (
+	"github.com/hailocab/i18n-go/locale"
+)
+
+// SymbolMode selects what identifies the currency in formatted Money.
+type SymbolMode int
+
+const (
+	// SymbolDefault uses the currency symbol, e.g. $, or the currency code
+	// if the currency is unknown.
+	SymbolDefault SymbolMode = iota
+	// SymbolNone omits the currency.
+	SymbolNone
+	// SymbolCode uses the ISO 4217 currency code, e.g. USD.
+	SymbolCode
+	// SymbolNarrow uses the narrow currency symbol, which may be shared
+	// by other currencies, e.g. $ for USD and CAD.
+	SymbolNarrow
+	// SymbolFull uses the currency symbol that distinguishes it from
+	// other currencies, e.g. US$ for USD and CA$ for CAD.
+	SymbolFull
+	// SymbolName uses the name of the currency in the language of the
+	// locale behind the number, e.g. 1,234.56 US dollars, or the currency
+	// code if the currency is unknown.
+	SymbolName
+)
+
+// NegativeStyle selects how negative amounts are formatted.
+type NegativeStyle int
+
+const (
+	// NegativeMinus uses the negative pattern of the locale, e.g. -$1.00.
+	NegativeMinus NegativeStyle = iota
+	// NegativeParentheses wraps the positive pattern of the locale in
+	// parentheses, e.g. ($1.00).
+	NegativeParentheses
+)
+
+// The package-wide default locale (see SetDefaultLocale).
+var defaultLocale string
+
+// Returns the package-wide default locale used by FormatDefault. Unless
+// it is set with SetDefaultLocale, this is the default locale of the
+// locale package (see locale.SetDefault).
+func GetDefaultLocale() string {
+	mu.RLock()
+	loc := defaultLocale
+	mu.RUnlock()
+	if loc == "" {
+		return locale.Default()
+	}
+	return loc
+}
+
+// Resets the package-wide default locale used by FormatDefault and
+// FormatCtx, e.g. for applications that serve a single region. Like the
+// decimal place, the default locale is process-global. An empty locale
+// restores the default locale of the locale package.
+func SetDefaultLocale(loc string) {
+	mu.Lock()
+	defaultLocale = loc
+	mu.Unlock()
+}
+
+// Formats m like Format with the package-wide default locale (see
+// SetDefaultLocale).
+func (m *Money) FormatDefault() string {
+	return m.Format(GetDefaultLocale())
+}
+
+// FormatOptions controls how Money is formatted by FormatWith.
+// The zero value formats like Format.
+type FormatOptions struct {
+	// Decimals overrides the number of decimal places. The amount is
+	// rounded with the package-wide rounding mode if it has more, and
+	// padded with zeros if it has fewer. Decimals is clamped to 0..MAXDEC.
+	Decimals *int
+	// SymbolMode selects what identifies the currency.
+	SymbolMode SymbolMode
+	// NegativeStyle selects how negative amounts are formatted.
+	NegativeStyle NegativeStyle
+	// ForceSign adds the positive sign of the locale to amounts greater
+	// than zero, e.g. +$1.00.
+	ForceSign bool
+	// NativeDigits uses the native digits of the locale and the
+	// separators that go with them instead of the ASCII digits 0-9, e.g.
+	// Arabic-Indic digits with ٫ and ٬ for ar_EG (see
+	// locale.Locale.NativeDigits).
+	NativeDigits bool
+	// DirectionalMarks wraps amounts formatted for a right-to-left locale
+	// in Unicode directional isolates, so that they are displayed
+	// correctly when embedded in left-to-right text.
+	DirectionalMarks bool
+}
+
+// The Unicode directional formatting characters used for DirectionalMarks.
+const (
+	rightToLeftIsolate    = "\u2067"
+	popDirectionalIsolate = "\u2069"
+)
+
+// Formats m like Format, but with the given options, e.g. with 0 decimal
+// places and the currency code:
+//
+//	d := 0
+//	m.FormatWith("en_US", FormatOptions{Decimals: &d, SymbolMode: SymbolCode})
+//
+// If the locale is unknown, String is returned.
+func (m *Money) FormatWith(loc string, opts FormatOptions) string {
+	l := locale.Get(loc)
+	if l == nil {
+		return m.String()
+	}
+	return m.format(l, opts)
+}
+
+// Formats m like Format, but with the positive sign of the locale in
+// front of amounts greater than zero, e.g. "+$5.00" and "-$3.00" for
+// en_US. Zero has no sign. The negative pattern of the locale is used
+// for negative amounts.
+func (m *Money) FormatSigned(loc string) string {
+	return m.FormatWith(loc, FormatOptions{ForceSign: true})
+}
+
+// Formats the amount of m for payment files and messages such as ISO
+// 20022 or SWIFT, independent of any locale: with a "." decimal
+// separator, no grouping, a leading "-" if negative and exactly the
+// decimal digits of the currency, e.g. "1234.56" for USD, "1234" for JPY
+// and "1.500" for BHD. The amount is rounded with the package-wide
+// rounding mode if it has more decimal places. If the currency is
+// unknown, the decimal places of m are used.
+func (m *Money) FormatISO() string {
+	value, dp, digits := m.M, m.scale(), m.serializedDigits()
+	if decimalPlaces(dp) > digits {
+		value = roundDigits(value, dp, digits)
+		dp = int64(newDecimal(digits))
+	}
+	return formatDecimal(value, dp, digits)
+}
+
+// Formats all items like Format with the locale loc, which is resolved
+// only once, e.g. for the rows of a report. Returns the String of each
+// item if the locale is unknown.
+func FormatAll(items []*Money, loc string) []string {
+	f, err := NewFormatter(loc)
+	formatted := make([]string, len(items))
+	for i, m := range items {
+		if err != nil {
+			formatted[i] = m.String()
+			continue
+		}
+		formatted[i] = f.Format(m)
+	}
+	return formatted
+}
@@ -0,0 +1,319 @@
+package money
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/hailocab/i18n-go/locale"
+)
+
+func TestFormatWith(t *testing.T) {
+	zero, one, three := 0, 1, 3
+	var fixtures = []struct {
+		m        *Money
+		locale   string
+		opts     FormatOptions
+		expected string
+	}{
+		{New(123456, "USD"), "en_US", FormatOptions{}, "$1,234.56"},
+		{New(-123456, "USD"), "en_US", FormatOptions{}, "($1,234.56)"},
+		{New(123456, "USD"), "en_US", FormatOptions{SymbolMode: SymbolNone}, "1,234.56"},
+		{New(123456, "USD"), "en_US", FormatOptions{SymbolMode: SymbolCode}, "USD 1,234.56"},
+		{New(-123456, "EUR"), "de_DE", FormatOptions{}, "-1.234,56 €"},
+		{New(-123456, "EUR"), "de_DE", FormatOptions{NegativeStyle: NegativeParentheses}, "(1.234,56 €)"},
+		{New(123456, "EUR"), "de_DE", FormatOptions{ForceSign: true}, "+1.234,56 €"},
+		{New(-123456, "EUR"), "de_DE", FormatOptions{ForceSign: true}, "-1.234,56 €"},
+		{New(0, "EUR"), "de_DE", FormatOptions{ForceSign: true}, "0,00 €"},
+		{New(123456, "USD"), "en_US", FormatOptions{Decimals: &zero}, "$1,235"},
+		{New(123449, "USD"), "en_US", FormatOptions{Decimals: &one}, "$1,234.5"},
+		{New(123456, "USD"), "en_US", FormatOptions{Decimals: &three}, "$1,234.560"},
+		{New(-40, "USD"), "en_US", FormatOptions{Decimals: &zero, ForceSign: true}, "$0"},
+		{New(-123456, "USD"), "en_US", FormatOptions{Decimals: &zero, SymbolMode: SymbolCode, NegativeStyle: NegativeParentheses}, "(USD 1,235)"},
+		{New(123456, "USD"), "xx_XX", FormatOptions{SymbolMode: SymbolNone}, "1234.56 USD"},
+		{New(123456, "USD"), "en_US", FormatOptions{SymbolMode: SymbolFull}, "US$1,234.56"},
+		{New(123456, "USD"), "en_US", FormatOptions{SymbolMode: SymbolNarrow}, "$1,234.56"},
+		{New(123456, "CAD"), "en_US", FormatOptions{SymbolMode: SymbolFull}, "CA$1,234.56"},
+		{New(123456, "HKD"), "en_US", FormatOptions{}, "HK$1,234.56"},
+		{New(123456, "HKD"), "en_US", FormatOptions{SymbolMode: SymbolNarrow}, "$1,234.56"},
+		{New(-123456, "USD"), "en_US", FormatOptions{SymbolMode: SymbolFull}, "(US$1,234.56)"},
+		{New(123456, "EUR"), "de_DE", FormatOptions{SymbolMode: SymbolFull}, "1.234,56 €"},
+		{New(123456, "XYZ"), "en_US", FormatOptions{SymbolMode: SymbolNarrow}, "XYZ 1,234.56"},
+		{New(123456, "EGP"), "ar_EG", FormatOptions{SymbolMode: SymbolCode}, "EGP 1,234.56"},
+		{New(123456, "EGP"), "ar_EG", FormatOptions{SymbolMode: SymbolCode, NativeDigits: true}, "EGP ١٬٢٣٤٫٥٦"},
+		{New(-123456, "EGP"), "ar_EG", FormatOptions{SymbolMode: SymbolCode, NativeDigits: true}, "EGP ١٬٢٣٤٫٥٦-"},
+		{New(123456, "EGP"), "ar_EG", FormatOptions{SymbolMode: SymbolNone, NativeDigits: true}, "١٬٢٣٤٫٥٦"},
+		{New(-123456, "EGP"), "ar_EG", FormatOptions{NativeDigits: true}, "ج.م.‏١٬٢٣٤٫٥٦-"},
+		{New(123456, "EGP"), "ar_EG", FormatOptions{SymbolMode: SymbolCode, DirectionalMarks: true}, "\u2067EGP 1,234.56\u2069"},
+		{New(123456, "EGP"), "ar_EG", FormatOptions{SymbolMode: SymbolNone, NativeDigits: true, DirectionalMarks: true}, "\u2067١٬٢٣٤٫٥٦\u2069"},
+		{New(123456, "MAD"), "ar_MA", FormatOptions{SymbolMode: SymbolNone, NativeDigits: true}, "1,234.56"},
+		{New(123456, "USD"), "en_US", FormatOptions{NativeDigits: true, DirectionalMarks: true}, "$1,234.56"},
+		{New(123456, "USD"), "en_US", FormatOptions{SymbolMode: SymbolName}, "1,234.56 US dollars"},
+		{New(-123456, "USD"), "en_US", FormatOptions{SymbolMode: SymbolName}, "-1,234.56 US dollars"},
+		{New(-123456, "USD"), "en_US", FormatOptions{SymbolMode: SymbolName, NegativeStyle: NegativeParentheses}, "(1,234.56 US dollars)"},
+		{New(100, "USD"), "en_US", FormatOptions{SymbolMode: SymbolName, Decimals: &zero}, "1 US dollar"},
+		{New(100, "USD"), "en_US", FormatOptions{SymbolMode: SymbolName}, "1.00 US dollars"},
+		{New(1, "JPY"), "en_US", FormatOptions{SymbolMode: SymbolName}, "1 Japanese yen"},
+		{New(500, "PLN"), "pl_PL", FormatOptions{SymbolMode: SymbolName, Decimals: &zero}, "5 złotych polskich"},
+		{New(250, "PLN"), "pl_PL", FormatOptions{SymbolMode: SymbolName}, "2,50 złotego polskiego"},
+		{New(123456, "XYZ"), "en_US", FormatOptions{SymbolMode: SymbolName}, "1,234.56 XYZ"},
+	}
+
+	for i, f := range fixtures {
+		if got := f.m.FormatWith(f.locale, f.opts); got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+	}
+}
+
+func TestFormatWithNegativeDecimals(t *testing.T) {
+	d := -1
+	if got := New(123456, "USD").FormatWith("en_US", FormatOptions{Decimals: &d}); got != "$1,235" {
+		t.Errorf("expected %q, got %q", "$1,235", got)
+	}
+	if d != -1 {
+		t.Errorf("expected options to be unchanged, got %v", d)
+	}
+}
+
+func TestFormatWithLargeDecimals(t *testing.T) {
+	four, twenty := 4, 20
+	var fixtures = []struct {
+		m        *Money
+		decimals *int
+		expected string
+	}{
+		{New(1e18, "USD"), &four, "$10,000,000,000,000,000.0000"},
+		{New(math.MaxInt64, "USD"), &four, "$92,233,720,368,547,758.0700"},
+		{New(math.MinInt64, "USD"), &four, "($92,233,720,368,547,758.0800)"},
+		{New(123, "USD"), &twenty, "$1.230000000000000000"},
+		{New(123, "JPY"), &four, "¥123.0000"},
+	}
+
+	for i, f := range fixtures {
+		if got := f.m.FormatWith("en_US", FormatOptions{Decimals: f.decimals}); got != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, got)
+		}
+	}
+}
+
+func TestFormatSigned(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		locale   string
+		expected string
+	}{
+		{New(500, "USD"), "en_US", "+$5.00"},
+		{New(-300, "USD"), "en_US", "($3.00)"},
+		{New(0, "USD"), "en_US", "$0.00"},
+		{New(500, "EUR"), "de_DE", "+5,00 €"},
+		{New(-300, "EUR"), "de_DE", "-3,00 €"},
+		{New(0, "EUR"), "de_DE", "0,00 €"},
+		{New(500, "GBP"), "en_GB", "+£5.00"},
+		{New(-300, "GBP"), "en_GB", "-£3.00"},
+	}
+
+	for i, f := range fixtures {
+		if got := f.m.FormatSigned(f.locale); got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+	}
+}
+
+func TestFormatISO(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		expected string
+	}{
+		{New(123456, "USD"), "1234.56"},
+		{New(123456789, "USD"), "1234567.89"},
+		{New(-123456, "USD"), "-1234.56"},
+		{New(5, "USD"), "0.05"},
+		{New(-5, "USD"), "-0.05"},
+		{New(0, "USD"), "0.00"},
+		{New(1234, "JPY"), "1234"},
+		{New(-1234, "JPY"), "-1234"},
+		{New(1500, "BHD"), "1.500"},
+		{New(-1, "BHD"), "-0.001"},
+		{NewWithScale(123456, "USD", 3), "123.46"},
+		{NewWithScale(123454, "USD", 3), "123.45"},
+		{NewWithScale(1234, "BHD", 2), "12.340"},
+		{NewWithScale(1234, "XYZ", 1), "123.4"},
+	}
+
+	for i, f := range fixtures {
+		if s := f.m.FormatISO(); s != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, s)
+		}
+	}
+}
+
+func TestFormatWithoutGrouping(t *testing.T) {
+	var fixtures = []struct {
+		sizes    []int
+		expected string
+	}{
+		{nil, "$1234567.00"},
+		{[]int{}, "$1234567.00"},
+		{[]int{0}, "$1234567.00"},
+		{[]int{3}, "$1,234,567.00"},
+	}
+
+	for i, f := range fixtures {
+		l := *locale.Get("en_US")
+		l.CurrencyGroupSizes = f.sizes
+		if got := New(123456700, "USD").format(&l, FormatOptions{}); got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+	}
+}
+
+func TestFormatNegativeSign(t *testing.T) {
+	var fixtures = []struct {
+		pattern  string
+		sign     string
+		expected string
+	}{
+		{"-n $", "", "-1.234,56 €"},
+		{"-n $", "-", "-1.234,56 €"},
+		{"n $-", "-", "1.234,56 €-"},
+		{"-n $", "−", "−1.234,56 €"},
+		{"n $-", "−", "1.234,56 €−"},
+		{"($n)", "−", "(€1.234,56)"},
+	}
+
+	for i, f := range fixtures {
+		l := *locale.Get("de_DE")
+		l.CurrencyNegativePattern = f.pattern
+		l.CurrencyNegativeSign = f.sign
+		if got := New(-123456, "EUR").format(&l, FormatOptions{}); got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+	}
+
+	if got := New(-123456, "SEK").Format("sv_SE"); got != "−1.234,56 kr" {
+		t.Errorf("expected %q, got %q", "−1.234,56 kr", got)
+	}
+	if got := New(-123456, "AED").Format("ar_AE"); got != "د.إ.\u200f1,234.56-" {
+		t.Errorf("expected %q, got %q", "د.إ.\u200f1,234.56-", got)
+	}
+}
+
+func TestFormatAll(t *testing.T) {
+	items := []*Money{
+		New(123456, "USD"),
+		New(-123456, "USD"),
+		New(1234, "JPY"),
+		New(0, "EUR"),
+	}
+
+	for _, loc := range []string{"en_US", "de_DE", "xx_XX"} {
+		got := FormatAll(items, loc)
+		if len(got) != len(items) {
+			t.Fatalf("%v: expected %d strings, got %d", loc, len(items), len(got))
+		}
+		for i, m := range items {
+			if got[i] != m.Format(loc) {
+				t.Errorf("%v: %d. expected %q, got %q", loc, i, m.Format(loc), got[i])
+			}
+		}
+	}
+
+	if got := FormatAll(nil, "en_US"); len(got) != 0 {
+		t.Errorf("expected no strings, got %v", got)
+	}
+}
+
+func benchmarkItems() []*Money {
+	items := make([]*Money, 10000)
+	for i := range items {
+		items[i] = New(int64(i)*12345-50000000, "USD")
+	}
+	return items
+}
+
+func BenchmarkFormat(b *testing.B) {
+	items := benchmarkItems()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, m := range items {
+			m.Format("en_US")
+		}
+	}
+}
+
+func BenchmarkFormatAll(b *testing.B) {
+	items := benchmarkItems()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		FormatAll(items, "en_US")
+	}
+}
+
+func TestFormatDefault(t *testing.T) {
+	defer SetDefaultLocale("")
+
+	m := New(-123456, "EUR")
+	if got := GetDefaultLocale(); got != locale.Default() {
+		t.Errorf("expected default locale %v, got %v", locale.Default(), got)
+	}
+	if got := m.FormatDefault(); got != m.Format(locale.Default()) {
+		t.Errorf("expected %q, got %q", m.Format(locale.Default()), got)
+	}
+
+	var fixtures = []struct {
+		locale   string
+		expected string
+	}{
+		{"de_DE", "-1.234,56 €"},
+		{"en_US", "(€1,234.56)"},
+		{"xx_XX", "-1234.56 EUR"},
+	}
+
+	for i, f := range fixtures {
+		SetDefaultLocale(f.locale)
+		if got := GetDefaultLocale(); got != f.locale {
+			t.Errorf("%d. expected default locale %v, got %v", i, f.locale, got)
+		}
+		if got := m.FormatDefault(); got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+	}
+}
+
+// Run with go test -race to detect unsynchronized access to the default
+// locale.
+func TestDefaultLocaleConcurrency(t *testing.T) {
+	defer SetDefaultLocale("")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			SetDefaultLocale("de_DE")
+		}()
+		go func() {
+			defer wg.Done()
+			New(123456, "EUR").FormatDefault()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestFormatRegisteredLocale(t *testing.T) {
+	l := *locale.Get("de_DE")
+	l.Code = "de_QQ"
+	l.CurrencyGroupSeparator = "'"
+	l.CurrencyDecimalSeparator = "."
+	if err := locale.Register(&l); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got := New(-123456789, "EUR").Format("de-QQ"); got != "-1'234'567.89 €" {
+		t.Errorf("expected %q, got %q", "-1'234'567.89 €", got)
+	}
+	if got := New(123456789, "EUR").Format("de_DE"); got != "1.234.567,89 €" {
+		t.Errorf("expected %q, got %q", "1.234.567,89 €", got)
+	}
+}
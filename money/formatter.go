@@ -0,0 +1,279 @@
+package money
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hailocab/i18n-go/currency"
+	"github.com/hailocab/i18n-go/locale"
+)
+
+// The kinds of currency patterns of a Formatter, by what identifies the
+// currency in the formatted Money.
+const (
+	withSymbol = iota
+	withCode
+	withoutSymbol
+	withName
+)
+
+// The replacers that turn a currency pattern with a symbol into one with
+// a code, which is not meant to be attached to the number, and into one
+// without a symbol, which also drops the space next to the symbol.
+var (
+	codeReplacer = strings.NewReplacer("$n", "$ n", "n$", "n $")
+	noneReplacer = strings.NewReplacer("$ ", "", " $", "", "$", "")
+)
+
+// Returns the currency pattern of the given kind for a pattern with a
+// symbol.
+func patternOf(pattern string, kind int) string {
+	switch kind {
+	case withCode:
+		return codeReplacer.Replace(pattern)
+	case withoutSymbol:
+		return noneReplacer.Replace(pattern)
+	}
+	return pattern
+}
+
+// Formatter formats Money for a locale. It resolves the locale and
+// prepares its patterns once, and caches the currencies it formats, so it
+// is faster than Format when many amounts are formatted, e.g. in a loop
+// or per request in a server. Currencies registered after a currency was
+// first formatted (see currency.Register) are not picked up. A Formatter
+// is safe for concurrent use.
+type Formatter struct {
+	l    *locale.Locale
+	opts FormatOptions
+	// The positive and negative patterns with a symbol and with a name
+	positive     string
+	negative     string
+	namePositive string
+	nameNegative string
+	negativeSign string
+	positiveSign string
+	// The positive and negative patterns of each kind and the currencies
+	// by code, only prepared by NewFormatter, as a Formatter for a single
+	// call of Format would not gain from them
+	patterns   *[4][2]string
+	currencies *sync.Map
+}
+
+// Returns a Formatter that formats Money like Format with the locale loc,
+// or ErrMoneyUnknownLocale if the locale is unknown.
+func NewFormatter(loc string) (*Formatter, error) {
+	l := locale.Get(loc)
+	if l == nil {
+		return nil, ErrMoneyUnknownLocale
+	}
+	f := newFormatter(l, FormatOptions{})
+	var patterns [4][2]string
+	for kind := range patterns {
+		patterns[kind] = [2]string{f.pattern(kind, false), f.pattern(kind, true)}
+	}
+	f.patterns = &patterns
+	f.currencies = new(sync.Map)
+	return f, nil
+}
+
+// Returns a Formatter that formats Money with the locale l and the
+// options opts. If the currency symbol is omitted, it is removed from the
+// patterns along with the space that separates it from the number. The
+// currency code is always separated from the number by a space. The
+// currency name follows the number like in the number patterns of the
+// locale.
+func newFormatter(l *locale.Locale, opts FormatOptions) *Formatter {
+	// Decimals are clamped to the supported decimal places
+	if opts.Decimals != nil && (*opts.Decimals < 0 || *opts.Decimals > MAXDEC) {
+		d := 0
+		if *opts.Decimals > MAXDEC {
+			d = MAXDEC
+		}
+		opts.Decimals = &d
+	}
+	f := &Formatter{
+		l:            l,
+		opts:         opts,
+		positive:     l.CurrencyPositivePattern,
+		negative:     l.CurrencyNegativePattern,
+		negativeSign: l.CurrencyNegativeSign,
+		positiveSign: l.PositiveSign,
+	}
+	f.namePositive, f.nameNegative = "n $", "-n $"
+	if l.NumberNegativePattern != "" {
+		f.nameNegative = strings.Replace(l.NumberNegativePattern, "n", "n $", 1)
+	}
+	if opts.NegativeStyle == NegativeParentheses {
+		f.negative = "(" + l.CurrencyPositivePattern + ")"
+		f.nameNegative = "(" + f.namePositive + ")"
+	}
+	if f.negativeSign == "" {
+		f.negativeSign = "-"
+	}
+	if f.positiveSign == "" {
+		f.positiveSign = "+"
+	}
+	return f
+}
+
+// Returns the positive or negative pattern of the given kind.
+func (f *Formatter) pattern(kind int, negative bool) string {
+	switch {
+	case f.patterns != nil && negative:
+		return f.patterns[kind][1]
+	case f.patterns != nil:
+		return f.patterns[kind][0]
+	case kind == withName && negative:
+		return f.nameNegative
+	case kind == withName:
+		return f.namePositive
+	case negative:
+		return patternOf(f.negative, kind)
+	}
+	return patternOf(f.positive, kind)
+}
+
+// Returns the currency with the code, or nil if it is unknown. Only
+// known currencies are cached by their exact code, so that unknown or
+// oddly spelled codes, e.g. from user input, do not grow the cache.
+func (f *Formatter) currency(code string) *currency.Currency {
+	if f.currencies == nil {
+		return currency.Get(code)
+	}
+	if c, found := f.currencies.Load(code); found {
+		return c.(*currency.Currency)
+	}
+	c := currency.Get(code)
+	if c != nil && c.Code == code {
+		f.currencies.Store(code, c)
+	}
+	return c
+}
+
+// Formats m with the locale of the Formatter, e.g. "$1,234.56" for en_US.
+func (f *Formatter) Format(m *Money) string {
+	l, opts := f.l, f.opts
+
+	// Money with its own decimal places is formatted with those
+	decimalDigits := l.CurrencyDecimalDigits
+	if m.dp > 0 {
+		decimalDigits = decimalPlaces(m.dp)
+	}
+	// DP is a measure for decimals: 2 decimal digits => dp = 10^2
+	dp := int64(newDecimal(decimalDigits))
+
+	// We use absolute values from here on, because the negative sign is
+	// part of the currency format pattern. Fewer decimals round the
+	// amount, more are padded with zeros.
+	value := m.Value()
+	if opts.Decimals != nil {
+		if *opts.Decimals < decimalDigits {
+			value = roundDigits(value, dp, *opts.Decimals)
+			dp = int64(newDecimal(*opts.Decimals))
+		}
+		decimalDigits = *opts.Decimals
+	}
+	absVal := uint64(value)
+	if value < 0 {
+		absVal = uint64(-value)
+	}
+
+	var frac string
+	if places := decimalPlaces(dp); places > 0 {
+		frac = strconv.FormatUint(absVal%uint64(dp), 10)
+		frac = strings.Repeat("0", places-len(frac)) + frac
+	}
+	frac += strings.Repeat("0", decimalDigits-len(frac))
+	sign := 0
+	switch {
+	case value < 0:
+		sign = -1
+	case value > 0:
+		sign = 1
+	}
+	return f.format(m, strconv.FormatUint(absVal/uint64(dp), 10), frac, sign)
+}
+
+// Formats the absolute amount with the whole number whole and the
+// decimals frac, which has the sign sign, with the currency of m.
+func (f *Formatter) format(m *Money, whole, frac string, sign int) string {
+	l, opts := f.l, f.opts
+
+	// Group the whole number and append the decimals. Crypto amounts
+	// with their own decimal places but no known currency (see
+	// NewCrypto) are not grouped.
+	c := f.currency(m.C)
+	decimalSep, groupSep := l.CurrencyDecimalSeparator, l.CurrencyGroupSeparator
+	if opts.NativeDigits {
+		decimalSep, groupSep = l.NativeSeparators()
+	}
+	formatted := whole
+	if m.dp == 0 || c != nil {
+		formatted = locale.GroupDigits(formatted, l.CurrencyGroupSizes, groupSep)
+	}
+	if frac != "" {
+		formatted += decimalSep + frac
+	}
+	if opts.NativeDigits {
+		formatted = l.LocalizeDigits(formatted)
+	}
+
+	var currencySymbol string
+	kind := withSymbol
+	switch opts.SymbolMode {
+	case SymbolCode:
+		currencySymbol = m.C
+	case SymbolDefault, SymbolNarrow, SymbolFull:
+		currencySymbol = symbolOf(c, m.C, opts.SymbolMode)
+	}
+	switch {
+	case opts.SymbolMode == SymbolName:
+		currencySymbol, kind = m.C, withName
+		if c != nil {
+			currencySymbol = currencyName(c, l.Code, whole, frac)
+		}
+	case currencySymbol == "":
+		kind = withoutSymbol
+	case currencySymbol == m.C:
+		kind = withCode
+	}
+	pattern := f.pattern(kind, sign < 0)
+
+	var buf bytes.Buffer
+	if opts.ForceSign && sign > 0 {
+		buf.WriteString(f.positiveSign)
+	}
+	// Replace in a single pass, as the currency symbol may contain an "n".
+	// The "-" in the pattern only marks the position of the negative sign.
+	for _, r := range pattern {
+		switch r {
+		case '$':
+			buf.WriteString(currencySymbol)
+		case 'n':
+			buf.WriteString(formatted)
+		case '-':
+			buf.WriteString(f.negativeSign)
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	s := buf.String()
+	if opts.DirectionalMarks && l.IsRTL() {
+		s = rightToLeftIsolate + s + popDirectionalIsolate
+	}
+	return s
+}
+
+// Returns the name of the currency c in the language of the tag for the
+// absolute amount with the whole number whole and the decimals frac, e.g.
+// "US dollar" for 1 and "US dollars" for 1.50 in "en".
+func currencyName(c *currency.Currency, tag, whole, frac string) string {
+	count, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || frac != "" {
+		return c.DisplayNameFraction(tag)
+	}
+	return c.DisplayName(count, tag)
+}
@@ -0,0 +1,73 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFormatter(t *testing.T) {
+	var fixtures = []*Money{
+		New(123456, "USD"),
+		New(-123456, "USD"),
+		New(0, "USD"),
+		New(5, "USD"),
+		New(123456789, "JPY"),
+		New(-1234567, "BHD"),
+		New(123456, "EUR"),
+		New(123456, "XYZ"),
+		NewWithScale(1234567, "USD", 3),
+		New(math.MaxInt64, "USD"),
+		New(math.MinInt64, "USD"),
+	}
+
+	for _, loc := range []string{"en_US", "de_DE", "de_CH", "fr_FR", "ja_JP", "hi_IN", "ar_EG", "sv_SE"} {
+		f, err := NewFormatter(loc)
+		if err != nil {
+			t.Fatalf("%v: expected no error, got %v", loc, err)
+		}
+		for i, m := range fixtures {
+			if got := f.Format(m); got != m.Format(loc) {
+				t.Errorf("%v: %d. expected %q, got %q", loc, i, m.Format(loc), got)
+			}
+		}
+	}
+
+	f, _ := NewFormatter("en_US")
+	if got := f.Format(New(math.MinInt64, "USD")); got != "($92,233,720,368,547,758.08)" {
+		t.Errorf("expected %q, got %q", "($92,233,720,368,547,758.08)", got)
+	}
+
+	if _, err := NewFormatter("xx_XX"); err != ErrMoneyUnknownLocale {
+		t.Errorf("expected error %v, got %v", ErrMoneyUnknownLocale, err)
+	}
+}
+
+func TestFormatterCache(t *testing.T) {
+	f, _ := NewFormatter("en_US")
+	for _, code := range []string{"USD", "usd", " USD", "XYZ", "ABC", "USD"} {
+		f.Format(New(123456, code))
+	}
+
+	var cached []string
+	f.currencies.Range(func(code, _ interface{}) bool {
+		cached = append(cached, code.(string))
+		return true
+	})
+	if len(cached) != 1 || cached[0] != "USD" {
+		t.Errorf("expected only %v to be cached, got %v", "USD", cached)
+	}
+}
+
+func BenchmarkFormatter(b *testing.B) {
+	items := benchmarkItems()
+	f, err := NewFormatter("en_US")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, m := range items {
+			f.Format(m)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package money
+
+import (
+	"math/big"
+	"sort"
+)
+
+// Ledger sums a stream of Money per currency, e.g. the entries of a
+// ledger summary. Unlike MoneyBag, the totals are kept as BigMoney, so
+// adding entries never overflows, no matter how many there are. Only a
+// total that does not fit into a Money in the end overflows, see Total.
+// The zero value is an empty ledger.
+type Ledger struct {
+	totals map[string]*BigMoney
+}
+
+// Adds m to the total of its currency. m is left unchanged.
+func (l *Ledger) Add(m *Money) {
+	if l.totals == nil {
+		l.totals = make(map[string]*BigMoney)
+	}
+	if total, found := l.totals[m.C]; found {
+		total.Add(m.Big())
+		return
+	}
+	l.totals[m.C] = m.Big()
+}
+
+// Returns the currency codes in the ledger, sorted.
+func (l *Ledger) Currencies() []string {
+	codes := make([]string, 0, len(l.totals))
+	for c := range l.totals {
+		codes = append(codes, c)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Returns the total of the currency c as a new Money, or Zero(c) if the
+// ledger has no entries of that currency. The total has the finest
+// decimal places of the entries. Total panics with ErrMoneyOverflow if
+// the total does not fit into a Money (see BigTotal).
+func (l *Ledger) Total(c string) *Money {
+	total, found := l.totals[c]
+	if !found {
+		return Zero(c)
+	}
+	m, err := total.Money()
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Returns the total of the currency c like Total, but as a new BigMoney,
+// which cannot overflow.
+func (l *Ledger) BigTotal(c string) *BigMoney {
+	total, found := l.totals[c]
+	if !found {
+		return Zero(c).Big()
+	}
+	return &BigMoney{M: new(big.Int).Set(total.M), C: total.C, dp: total.dp}
+}
+
+// Returns the totals of all currencies in the ledger as new Money, sorted
+// by currency code. Totals panics like Total.
+func (l *Ledger) Totals() []*Money {
+	totals := make([]*Money, 0, len(l.totals))
+	for _, c := range l.Currencies() {
+		totals = append(totals, l.Total(c))
+	}
+	return totals
+}
@@ -0,0 +1,82 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestLedger(t *testing.T) {
+	entries := []*Money{
+		New(1000, "USD"),
+		New(250, "EUR"),
+		New(550, "USD"),
+		New(-50, "EUR"),
+		New(1, "GBP"),
+		New(1234, "JPY"),
+		NewWithScale(12345, "USD", 3),
+	}
+
+	var l Ledger
+	for _, m := range entries {
+		l.Add(m)
+	}
+
+	var fixtures = []struct {
+		c        string
+		expected string
+	}{
+		{"EUR", "2.00 EUR"},
+		{"GBP", "0.01 GBP"},
+		{"JPY", "1234 JPY"},
+		{"USD", "27.845 USD"},
+		{"CHF", "0.00 CHF"},
+	}
+
+	for i, f := range fixtures {
+		if got := l.Total(f.c); got.String() != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, got)
+		}
+	}
+
+	totals := l.Totals()
+	if len(totals) != 4 {
+		t.Fatalf("expected %d totals, got %d", 4, len(totals))
+	}
+	for i, m := range totals {
+		if m.String() != fixtures[i].expected {
+			t.Errorf("%d. expected %v, got %v", i, fixtures[i].expected, m)
+		}
+	}
+
+	// The entries are unchanged and the totals are copies
+	if entries[0].M != 1000 || entries[1].M != 250 {
+		t.Errorf("expected entries to be unchanged, got %v and %v", entries[0], entries[1])
+	}
+	totals[0].Set(0)
+	if got := l.Total("EUR"); got.M != 200 {
+		t.Errorf("expected ledger to be unchanged, got %v", got)
+	}
+}
+
+func TestLedgerOverflow(t *testing.T) {
+	var l Ledger
+	l.Add(New(9223372036854775807, "USD"))
+	l.Add(New(1, "USD"))
+
+	if got := l.BigTotal("USD").String(); got != "92233720368547758.08 USD" {
+		t.Errorf("expected %v, got %v", "92233720368547758.08 USD", got)
+	}
+	func() {
+		defer func() {
+			if r := recover(); r != ErrMoneyOverflow {
+				t.Errorf("expected panic %v, got %v", ErrMoneyOverflow, r)
+			}
+		}()
+		l.Total("USD")
+	}()
+
+	// Intermediate totals may overflow as long as the final one does not
+	l.Add(New(-2, "USD"))
+	if got := l.Total("USD"); got.M != 9223372036854775806 {
+		t.Errorf("expected %v, got %v", int64(9223372036854775806), got.M)
+	}
+}
@@ -5,24 +5,46 @@
 package money
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"github.com/hailocab/i18n-go/currency"
 	"github.com/hailocab/i18n-go/locale"
 	"math"
+	"math/big"
+	"strconv"
 	"strings"
+	"sync"
 )
 
+// Money is an amount M in minor units of the currency C. Create it with
+// New or a keyed literal like Money{M: 123, C: "EUR"}; positional
+// literals do not compile because of the unexported decimal places.
 type Money struct {
 	M int64
 	C string
+	// dp is the decimal factor of M, e.g. 1000 for 3 decimal places,
+	// or 0 if M uses the package-wide DP (see NewWithScale).
+	dp int64
 }
 
 var (
 	ErrMoneyOverflow              = errors.New("i18n: money overflow")
 	ErrMoneyDivideByZero          = errors.New("i18n: money division by zero")
 	ErrMoneyDecimalPlacesTooLarge = errors.New("i18n: money decimal places too large")
+	ErrCurrencyMismatch           = errors.New("i18n: money currency mismatch")
+	ErrMoneyInvalidAllocation     = errors.New("i18n: money invalid allocation")
+	ErrMoneyInvalidRate           = errors.New("i18n: money invalid exchange rate")
+	ErrMoneyUnknownRate           = errors.New("i18n: money unknown exchange rate")
+	ErrMoneyNoItems               = errors.New("i18n: money no items")
+	ErrMoneyUnsupportedLanguage   = errors.New("i18n: money unsupported language")
+	ErrMoneyInvalidEncoding       = errors.New("i18n: money invalid encoding")
+	ErrMoneyNotFinite             = errors.New("i18n: money not a finite number")
+	ErrMoneyUnknownCurrency       = errors.New("i18n: money unknown currency")
+	ErrMoneyUnknownLocale         = errors.New("i18n: money unknown locale")
+	ErrMoneyInvalidRange          = errors.New("i18n: money invalid range")
+	ErrMoneyNil                   = errors.New("i18n: money is nil")
+	ErrMoneyInvalidPrecision      = errors.New("i18n: money precision exceeds currency")
+	ErrMoneyInvalidPeriods        = errors.New("i18n: money invalid number of periods")
 
 	Guardi int     = 100
 	Guard  int64   = int64(Guardi)
@@ -31,12 +53,45 @@ var (
 	DPf    float64 = float64(DP) // for default of 2 decimal places => 10^2 (can be reset)
 	Round          = .5
 	Roundn         = Round * -1
+
+	// scaleByCurrency is whether New uses the decimal digits of known
+	// currencies rather than DP, see SetScaleByCurrency.
+	scaleByCurrency = true
+
+	// mu guards DP, DPf, scaleByCurrency, the rounding mode and the
+	// default locale, which are process-global and may be changed while
+	// other goroutines do money arithmetic.
+	mu sync.RWMutex
 )
 
 const (
 	MAXDEC = 18
 )
 
+// The powers of ten up to 10^MAXDEC, i.e. the decimal factors of all
+// possible decimal places.
+var pow10 = [MAXDEC + 1]int64{
+	1,
+	10,
+	100,
+	1000,
+	10000,
+	100000,
+	1000000,
+	10000000,
+	100000000,
+	1000000000,
+	10000000000,
+	100000000000,
+	1000000000000,
+	10000000000000,
+	100000000000000,
+	1000000000000000,
+	10000000000000000,
+	100000000000000000,
+	1000000000000000000,
+}
+
 func newDecimal(d int) int {
 	if d < 0 {
 		panic(ErrMoneyDivideByZero)
@@ -44,51 +99,348 @@ func newDecimal(d int) int {
 	if d > MAXDEC {
 		panic(ErrMoneyDecimalPlacesTooLarge)
 	}
-	var newDec int
-	if d > 0 {
-		newDec++
-		for i := 0; i < d; i++ {
-			newDec *= 10
-		}
+	return int(pow10[d])
+}
+
+// Returns the number of decimal places of dp, e.g. 2 for 100.
+func decimalPlaces(dp int64) int {
+	d := 0
+	for ; dp > 1; dp /= 10 {
+		d++
 	}
-	return newDec
+	return d
+}
+
+// Returns m minor units with decimal factor dp as a decimal string
+// like "-1234.56" with at least digits decimal places. More decimal
+// places are used if needed to represent the value exactly.
+func formatDecimal(m int64, dp int64, digits int) string {
+	places := decimalPlaces(dp)
+	for places > digits && m%10 == 0 {
+		m /= 10
+		dp /= 10
+		places--
+	}
+	sign := ""
+	u := uint64(m)
+	if m < 0 {
+		sign = "-"
+		u = uint64(-m)
+	}
+	whole := fmt.Sprintf("%s%d", sign, u/uint64(dp))
+	frac := ""
+	if places > 0 {
+		frac = fmt.Sprintf("%0*d", places, u%uint64(dp))
+	}
+	if digits > places {
+		frac += strings.Repeat("0", digits-places)
+	}
+	if frac == "" {
+		return whole
+	}
+	return whole + "." + frac
 }
 
 // New returns a new Money that can be used for money arithmetic.
+// The amount m is given in the minor unit of the currency, i.e. it has
+// the decimal digits of the currency as its decimal places, e.g. New(1000,
+// "JPY") is 1000 yen and New(1000, "USD") is 10.00 dollars. Money of an
+// unknown currency uses the package-wide DP, as does all Money if
+// SetScaleByCurrency(false) was called.
 func New(m int64, c string) *Money {
-	return &Money{m, c}
+	return &Money{M: m, C: c, dp: newScale(c)}
 }
 
-// Resets the package-wide decimal place (default is 2 decimal places).
-func SetDecimal(d int) {
-	decimal := newDecimal(d)
+// Zero returns a new Money with the amount zero in the currency c, like
+// New(0, c). It is the starting point to accumulate amounts, e.g.
+//
+//	total := money.Zero("USD")
+//	for _, item := range items {
+//		total.Add(item)
+//	}
+func Zero(c string) *Money {
+	return New(0, c)
+}
+
+// Returns the decimal factor of the currency c, or 0 if c is unknown.
+func currencyScale(c string) int64 {
+	if cur := currency.Get(c); cur != nil {
+		return int64(newDecimal(cur.DecimalDigits))
+	}
+	return 0
+}
+
+// Returns the decimal factor of Money in the currency c created by New,
+// i.e. that of the currency unless SetScaleByCurrency(false) was called,
+// or 0 for the package-wide DP.
+func newScale(c string) int64 {
+	if !GetScaleByCurrency() {
+		return 0
+	}
+	return currencyScale(c)
+}
+
+// NewWithScale returns a new Money with its own number of decimal places,
+// e.g. NewWithScale(1234, "BHD", 3) for 1.234 BHD. It is not affected by
+// the package-wide DP, so amounts with different decimal places can be
+// used side by side.
+func NewWithScale(m int64, c string, digits int) *Money {
+	return &Money{M: m, C: c, dp: int64(newDecimal(digits))}
+}
+
+// NewCrypto returns a new Money of minor units of a crypto currency like
+// NewWithScale, e.g. NewCrypto(150000000, "BTC", 8) for 1.5 bitcoin. It
+// is formatted with all decimal places and without grouping.
+func NewCrypto(minor int64, symbol string, decimals int) *Money {
+	if decimals < 0 {
+		panic(ErrMoneyDecimalPlacesTooLarge)
+	}
+	return NewWithScale(minor, symbol, decimals)
+}
+
+// NewValidated returns a new Money like New, but returns
+// ErrMoneyUnknownCurrency if the currency code is not known. The code
+// may be in any case and is stored in upper case, e.g. "usd" as USD.
+func NewValidated(m int64, code string) (*Money, error) {
+	c := currency.Get(code)
+	if c == nil {
+		return nil, ErrMoneyUnknownCurrency
+	}
+	return New(m, c.Code), nil
+}
+
+// Validate checks that m can safely be used, e.g. after it was received
+// at an API boundary. It returns ErrMoneyNil if m is nil,
+// ErrMoneyUnknownCurrency if the currency is not known and
+// ErrMoneyInvalidPrecision if the amount has more decimal places than the
+// currency, e.g. 1.234 USD. Trailing zeros do not count, so 1.2300 USD
+// with 4 decimal places is valid.
+func (m *Money) Validate() error {
+	if m == nil {
+		return ErrMoneyNil
+	}
+	c := currency.Get(m.C)
+	if c == nil {
+		return ErrMoneyUnknownCurrency
+	}
+	if places := decimalPlaces(m.scale()); places > c.DecimalDigits && m.M%pow10[places-c.DecimalDigits] != 0 {
+		return ErrMoneyInvalidPrecision
+	}
+	return nil
+}
+
+// Returns the decimal factor of m, e.g. 100 for 2 decimal places.
+func (m *Money) scale() int64 {
+	if m.dp > 0 {
+		return m.dp
+	}
+	return getDP()
+}
+
+// Returns a new Money with amount x and the currency and decimal places
+// of m.
+func (m *Money) withAmount(x int64) *Money {
+	return &Money{M: x, C: m.C, dp: m.dp}
+}
+
+// Returns the amounts of m and n with the same decimal factor, which is
+// the finer of the two, along with that factor.
+func align(m, n *Money) (a, b, dp int64, err error) {
+	a, b, dp = m.M, n.M, m.scale()
+	if n.scale() == dp {
+		return a, b, dp, nil
+	}
+	if n.scale() > dp {
+		dp = n.scale()
+		a, err = mulInt64(a, dp/m.scale())
+	} else {
+		b, err = mulInt64(b, dp/n.scale())
+	}
+	return a, b, dp, err
+}
+
+// Returns a new Money with the amount of m multiplied by x, rounded with
+// the given rounding mode, and the currency and decimal places of m.
+func (m *Money) mulRat(x *big.Rat, mode RoundingMode) (*Money, error) {
+	r, err := mode.roundRat(new(big.Rat).Mul(new(big.Rat).SetInt64(m.M), x))
+	if err != nil {
+		return nil, err
+	}
+	return m.withAmount(r), nil
+}
+
+// Returns a*b or ErrMoneyOverflow if the result overflows.
+func mulInt64(a, b int64) (int64, error) {
+	r := a * b
+	if (a != 0 && r/a != b) || (a == -1 && b == math.MinInt64) {
+		return 0, ErrMoneyOverflow
+	}
+	return r, nil
+}
+
+// NewFromString returns a new Money parsed from a decimal string like
+// "1234.56" or "-0.10". The string may have an optional sign, and at
+// most as many decimal places as the currency (see New) allows. Grouping,
+// spaces and exponents are not accepted. Unlike Setf, the value is parsed
+// exactly without going through a float64.
+func NewFromString(s, c string) (*Money, error) {
+	m := New(0, c)
+	x, err := parseDecimal(s, decimalPlaces(m.scale()))
+	if err == ErrMoneyDecimalPlacesTooLarge || err == ErrMoneyOverflow {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("i18n: money cannot parse %q: %v", s, err)
+	}
+	return m.Set(x), nil
+}
+
+// NewFromFloat returns a new Money of f units of the currency c, rounded
+// to the decimal digits of the currency, e.g. 1001 yen for 1000.7 JPY.
+// Use NewFromString for exact input. It panics like Setf.
+func NewFromFloat(f float64, c string) *Money {
+	x, err := decimalRat(f)
+	if err != nil {
+		panic(err)
+	}
+	m := NewFromMinorUnits(0, c)
+	r, err := GetRoundingMode().roundRat(x.Mul(x, new(big.Rat).SetInt64(m.scale())))
+	if err != nil {
+		panic(err)
+	}
+	return m.Set(r)
+}
+
+// Returns the shortest decimal that converts to f as a big.Rat, e.g.
+// exactly 1.005 for 1.005, or ErrMoneyNotFinite if f is NaN or infinite.
+func decimalRat(f float64) (*big.Rat, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, ErrMoneyNotFinite
+	}
+	x, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	return x, nil
+}
+
+// NewFromMinorUnits returns a new Money of minor units of the currency c,
+// e.g. NewFromMinorUnits(500, "USD") for 5.00 dollars. It is the same as
+// New, but makes the unit of the amount explicit and always uses the
+// decimal digits of a known currency (see SetScaleByCurrency).
+func NewFromMinorUnits(minor int64, c string) *Money {
+	return &Money{M: minor, C: c, dp: currencyScale(c)}
+}
+
+// FromMinor returns a new Money of minor units of the currency with the
+// ISO 4217 code, e.g. cents for USD, yen for JPY or fils for BHD, so
+// FromMinor(1234, "BHD") is 1.234 dinars. The amount always has the
+// decimal digits of the currency, independent of the package-wide DP.
+// Returns ErrMoneyUnknownCurrency if the code is not known, as its minor
+// unit is not known either.
+func FromMinor(minor int64, code string) (*Money, error) {
+	c := currency.Get(code)
+	if c == nil {
+		return nil, ErrMoneyUnknownCurrency
+	}
+	return NewFromMinorUnits(minor, c.Code), nil
+}
+
+// NewFromMajorUnits returns a new Money of whole units of the currency c,
+// e.g. NewFromMajorUnits(5, "USD") for 5.00 dollars or
+// NewFromMajorUnits(5, "JPY") for 5 yen. It panics with ErrMoneyOverflow
+// if the amount in minor units overflows.
+func NewFromMajorUnits(major int64, c string) *Money {
+	m := New(0, c)
+	x, err := mulInt64(major, m.scale())
+	if err != nil {
+		panic(err)
+	}
+	return m.Set(x)
+}
+
+// Returns DP, safe for concurrent use with SetDecimal.
+func getDP() int64 {
+	mu.RLock()
+	defer mu.RUnlock()
+	return DP
+}
+
+func setDP(decimal int) {
+	mu.Lock()
 	DPf = float64(decimal)
 	DP = int64(decimal)
+	mu.Unlock()
+}
+
+// Returns the package-wide decimal place.
+func GetDecimal() int {
+	return decimalPlaces(getDP())
+}
+
+// Returns whether New uses the decimal digits of known currencies, see
+// SetScaleByCurrency.
+func GetScaleByCurrency() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return scaleByCurrency
+}
+
+// Sets whether New and the currency setters like Setc use the decimal
+// digits of a known currency (the default), e.g. 1000 yen for
+// New(1000, "JPY"), or the package-wide DP for all currencies like
+// earlier versions did, e.g. 10.00 JPY. Code that relies on SetDecimal
+// for known currencies must call SetScaleByCurrency(false). Like
+// SetDecimal, the setting is process-global and does not change existing
+// Money. NewFromMinorUnits, FromMinor and NewFromFloat always use the
+// decimal digits of the currency.
+func SetScaleByCurrency(on bool) {
+	mu.Lock()
+	scaleByCurrency = on
+	mu.Unlock()
+}
+
+// Resets the package-wide decimal place (default is 2 decimal places).
+// The decimal place is process-global: changing it affects all Money
+// without its own decimal places (i.e. not created with New for a known
+// currency or with NewWithScale, see SetScaleByCurrency) in all
+// goroutines.
+// Use SetDecimal rather than assigning DP directly, which is not safe
+// for concurrent use.
+func SetDecimal(d int) {
+	setDP(newDecimal(d))
 	return
 }
 
-// Resets the package-wide decimal place by currency.
+// Resets the package-wide decimal place by currency (see SetDecimal).
 func SetDecimalByCurrency(cur string) {
 	c := currency.Get(cur)
 	if c != nil {
-		decimal := newDecimal(c.DecimalDigits)
-		DPf = float64(decimal)
-		DP = int64(decimal)
+		setDP(newDecimal(c.DecimalDigits))
 	}
 	return
 }
 
-// Resets the package-wide decimal place by locale.
+// Resets the package-wide decimal place by locale (see SetDecimal).
 func SetDecimalByLocale(lce string) {
 	l := locale.Get(lce)
 	if l != nil {
-		decimal := newDecimal(l.CurrencyDecimalDigits)
-		DPf = float64(decimal)
-		DP = int64(decimal)
+		setDP(newDecimal(l.CurrencyDecimalDigits))
 	}
 	return
 }
 
+// Returns the currency shared by m and n. An empty currency matches any
+// other currency, so that Money without a currency can be used in
+// arithmetic with Money that has one. Returns ErrCurrencyMismatch if the
+// currencies differ.
+func commonCurrency(m, n *Money) (string, error) {
+	switch {
+	case m.C == n.C || n.C == "":
+		return m.C, nil
+	case m.C == "":
+		return n.C, nil
+	}
+	return "", ErrCurrencyMismatch
+}
+
 // Rounds int64 remainder rounded half towards plus infinity
 // trunc = the remainder of the float64 calc
 // r     = the result of the int64 cal
@@ -105,7 +457,8 @@ func Rnd(r int64, trunc float64) int64 {
 	return r
 }
 
-// Returns the absolute value of Money.
+// Returns the absolute value of Money. Abs modifies and returns m (see
+// Absolute for a version that leaves m unchanged).
 func (m *Money) Abs() *Money {
 	if m.M < 0 {
 		m.Neg()
@@ -113,46 +466,309 @@ func (m *Money) Abs() *Money {
 	return m
 }
 
-// Adds two money types.
+// Returns the absolute value of m as a new Money, leaving m unchanged.
+// It panics with ErrMoneyOverflow for the most negative amount.
+func (m *Money) Absolute() *Money {
+	if m.M < 0 {
+		return m.Negated()
+	}
+	return m.withAmount(m.M)
+}
+
+// Adds two money types. Add modifies and returns m (see Plus for a
+// version that leaves m unchanged). It panics if the currencies differ.
 func (m *Money) Add(n *Money) *Money {
-	r := m.M + n.M
-	if (r^m.M)&(r^n.M) < 0 {
-		panic(ErrMoneyOverflow)
+	if _, err := m.AddErr(n); err != nil {
+		panic(err)
 	}
-	m.M = r
 	return m
 }
 
-// Divides one Money type from another.
+// Adds two money types like Add, but returns ErrMoneyOverflow or
+// ErrCurrencyMismatch instead of panicking. m is left unchanged on error.
+func (m *Money) AddErr(n *Money) (*Money, error) {
+	c, err := commonCurrency(m, n)
+	if err != nil {
+		return nil, err
+	}
+	a, b, dp, err := align(m, n)
+	if err != nil {
+		return nil, err
+	}
+	r := a + b
+	if (r^a)&(r^b) < 0 {
+		return nil, ErrMoneyOverflow
+	}
+	if dp != m.scale() {
+		m.dp = dp
+	}
+	m.M = r
+	m.C = c
+	return m, nil
+}
+
+// Returns the amount of m in whole units, truncated towards zero, e.g. 12
+// for 12.99 USD. Same as Gett.
+func (m *Money) AsMajorUnits() int64 {
+	return m.M / m.scale()
+}
+
+// Returns the amount of m in units as a float64, e.g. 12.99 for 12.99 USD.
+// Same as Get. The result may not be exact.
+func (m *Money) AsMajorUnitsFloat() float64 {
+	return float64(m.M) / float64(m.scale())
+}
+
+// Returns the amount of m in minor units, e.g. 1299 for 12.99 USD or 1299
+// for 1299 JPY. Same as Value. The minor units have the decimal places
+// of m, which are those of the currency for Money created with New.
+func (m *Money) AsMinorUnits() int64 {
+	return m.M
+}
+
+// Returns a copy of m with the same amount, currency and decimal places.
+// The copy is independent of m, so it can be used to keep the value of m
+// before calling methods that modify it. Use *m.Copy() to get a Money
+// value rather than a pointer.
+func (m *Money) Copy() *Money {
+	return m.withAmount(m.M)
+}
+
+// Returns the absolute difference |m - n| as a new Money, leaving both m
+// and n unchanged, e.g. 3.00 for 5.00 and 8.00. Distance returns
+// ErrCurrencyMismatch if the currencies differ and ErrMoneyOverflow if
+// the difference overflows.
+func (m *Money) Distance(n *Money) (*Money, error) {
+	r, err := m.withAmount(m.M).SubErr(n)
+	if err != nil {
+		return nil, err
+	}
+	if r.M < 0 {
+		if r.M == math.MinInt64 {
+			return nil, ErrMoneyOverflow
+		}
+		r.M = -r.M
+	}
+	return r, nil
+}
+
+// Divides one Money type from another. Div modifies and returns m.
+// The quotient is computed exactly and rounded with the package-wide
+// rounding mode to the decimal places of m. Div panics with
+// ErrMoneyDivideByZero or ErrMoneyOverflow (see DivErr for a
+// non-panicking version).
 func (m *Money) Div(n *Money) *Money {
-	f := Guardf * DPf * float64(m.M) / float64(n.M) / Guardf
-	i := int64(f)
-	return m.Set(Rnd(i, f-float64(i)))
+	if _, err := m.DivErr(n); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Divides one Money type from another like Div, but returns
+// ErrMoneyDivideByZero or ErrMoneyOverflow instead of panicking.
+// m is left unchanged on error.
+func (m *Money) DivErr(n *Money) (*Money, error) {
+	if n.M == 0 {
+		return nil, ErrMoneyDivideByZero
+	}
+	x := new(big.Rat).SetFrac(big.NewInt(n.scale()), big.NewInt(n.M))
+	r, err := m.mulRat(x, GetRoundingMode())
+	if err != nil {
+		return nil, err
+	}
+	return m.Set(r.M), nil
+}
+
+// Returns the ratio of m to n as a float64, e.g. 0.5 for 50.00 USD to
+// 100.00 USD, taking differing decimal places into account. Returns
+// ErrCurrencyMismatch if the currencies differ and ErrMoneyDivideByZero
+// if n is zero.
+func (m *Money) Ratio(n *Money) (float64, error) {
+	if _, err := commonCurrency(m, n); err != nil {
+		return 0, err
+	}
+	if n.M == 0 {
+		return 0, ErrMoneyDivideByZero
+	}
+	x := new(big.Rat).SetFrac(
+		new(big.Int).Mul(big.NewInt(m.M), big.NewInt(n.scale())),
+		new(big.Int).Mul(big.NewInt(n.M), big.NewInt(m.scale())),
+	)
+	f, _ := x.Float64()
+	return f, nil
+}
+
+// Divides m by the quantity q and returns the quotient and the remainder
+// as new Money, leaving m unchanged. The quotient is truncated towards
+// zero and the remainder, in minor units, has the sign of m, so that
+// quotient * q + remainder equals m. Returns ErrMoneyDivideByZero if q is
+// zero and ErrMoneyOverflow if the quotient overflows.
+func (m *Money) DivInt(q int64) (quotient *Money, remainder *Money, err error) {
+	if q == 0 {
+		return nil, nil, ErrMoneyDivideByZero
+	}
+	if q == -1 && m.M == math.MinInt64 {
+		return nil, nil, ErrMoneyOverflow
+	}
+	return m.withAmount(m.M / q), m.withAmount(m.M % q), nil
+}
+
+// Divides m by the quantity n like DivInt, but with the quotient rounded
+// towards minus infinity (floor division). The remainder, in minor units,
+// has the sign of n, so that quotient * n + remainder equals m, e.g. 1.00
+// divided by 3 is 0.33 and 0.01, and by -3 is -0.34 and -0.02. Both are
+// new Money with the currency of m, and m is left unchanged. Returns
+// ErrMoneyDivideByZero if n is zero and ErrMoneyOverflow if the quotient
+// overflows.
+func (m *Money) DivMod(n int64) (q *Money, r *Money, err error) {
+	q, r, err = m.DivInt(n)
+	if err != nil {
+		return nil, nil, err
+	}
+	if r.M != 0 && (r.M < 0) != (n < 0) {
+		q.M--
+		r.M += n
+	}
+	return q, r, nil
 }
 
 // Gets value of money truncating after DP (see Value() for no truncation).
 func (m *Money) Gett() int64 {
-	return m.M / DP
+	return m.M / m.scale()
 }
 
 // Gets the float64 value of money (see Value() for int64).
 func (m *Money) Get() float64 {
-	return float64(m.M) / DPf
+	return float64(m.M) / float64(m.scale())
 }
 
-// Multiplies two Money types.
+// Implements fmt.GoStringer. Returns the Go expression that creates m,
+// followed by its value as a comment, e.g.
+// money.New(123456, "USD") /* 1234.56 USD */.
+func (m *Money) GoString() string {
+	var expr string
+	switch {
+	case m.dp > 0 && m.dp == newScale(m.C):
+		expr = fmt.Sprintf("money.New(%d, %q)", m.M, m.C)
+	case m.dp > 0:
+		expr = fmt.Sprintf("money.NewWithScale(%d, %q, %d)", m.M, m.C, decimalPlaces(m.dp))
+	default:
+		expr = fmt.Sprintf("&money.Money{M: %d, C: %q}", m.M, m.C)
+	}
+	return fmt.Sprintf("%s /* %s */", expr, m.String())
+}
+
+// Returns a compact, locale-independent string that identifies m, for
+// use as a map key, e.g. "USD:123456" for 1234.56 USD. The amount is given
+// in the decimal digits of the currency (or of the package-wide DP if the
+// currency is unknown), so that Money that Equals has the same key, e.g.
+// 1.50 USD and 1.500 USD. If the amount needs more decimal places, their
+// number is appended, e.g. "USD:123456:3" for 123.456 USD.
+func (m *Money) Key() string {
+	digits := decimalPlaces(getDP())
+	if c := currency.Get(m.C); c != nil {
+		digits = c.DecimalDigits
+	}
+	x, places := m.M, decimalPlaces(m.scale())
+	for places > digits && x%10 == 0 {
+		x /= 10
+		places--
+	}
+	if places < digits {
+		if y, err := mulInt64(x, pow10[digits-places]); err == nil {
+			x, places = y, digits
+		}
+	}
+	if places != digits {
+		return fmt.Sprintf("%s:%d:%d", m.C, x, places)
+	}
+	return fmt.Sprintf("%s:%d", m.C, x)
+}
+
+// Returns true if the amount is less than zero.
+func (m *Money) IsNegative() bool {
+	return m.M < 0
+}
+
+// Returns true if the amount is greater than zero.
+func (m *Money) IsPositive() bool {
+	return m.M > 0
+}
+
+// Returns true if the amount is zero.
+func (m *Money) IsZero() bool {
+	return m.M == 0
+}
+
+// Multiplies two Money types. Mul modifies and returns m (see Times for a
+// version that leaves m unchanged). The product is computed exactly and
+// rounded with the package-wide rounding mode. Mul panics with
+// ErrMoneyOverflow if the result overflows (see MulErr for a
+// non-panicking version).
 func (m *Money) Mul(n *Money) *Money {
-	return m.Set(m.M * n.M / DP)
+	if _, err := m.MulErr(n); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Multiplies two Money types like Mul, but returns ErrMoneyOverflow
+// instead of panicking. m is left unchanged on error.
+func (m *Money) MulErr(n *Money) (*Money, error) {
+	r, err := m.mulRat(big.NewRat(n.M, n.scale()), GetRoundingMode())
+	if err != nil {
+		return nil, err
+	}
+	return m.Set(r.M), nil
+}
+
+// Multiplies m by the quantity q and returns the result as a new Money,
+// leaving m unchanged. The result is exact. MulInt panics with
+// ErrMoneyOverflow if the result overflows.
+func (m *Money) MulInt(q int64) *Money {
+	r, err := mulInt64(m.M, q)
+	if err != nil {
+		panic(err)
+	}
+	return m.withAmount(r)
 }
 
 // Multiplies a Money with a float to return a money-stored type.
+// f is taken as the shortest decimal that converts to it (see
+// NewFromFloat), e.g. exactly 1.005. The product is computed exactly and
+// rounded with the package-wide rounding mode. Mulf panics with
+// ErrMoneyNotFinite if f is NaN or infinite and with ErrMoneyOverflow if
+// the result overflows (see MulfErr for a non-panicking version).
 func (m *Money) Mulf(f float64) *Money {
-	i := m.M * int64(f*Guardf*DPf)
-	r := i / Guard / DP
-	return m.Set(Rnd(r, float64(i)/Guardf/DPf-float64(r)))
+	if _, err := m.MulfErr(f); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Multiplies a Money with a float like Mulf, but returns
+// ErrMoneyNotFinite or ErrMoneyOverflow instead of panicking.
+// m is left unchanged on error.
+func (m *Money) MulfErr(f float64) (*Money, error) {
+	x, err := decimalRat(f)
+	if err != nil {
+		return nil, err
+	}
+	r, err := m.mulRat(x, GetRoundingMode())
+	if err != nil {
+		return nil, err
+	}
+	return m.Set(r.M), nil
 }
 
-// Returns the negative value of Money.
+// Subtracts n from m and returns the result as a new Money, leaving
+// both m and n unchanged. The currency is taken from m.
+func (m *Money) Minus(n *Money) *Money {
+	return m.withAmount(m.M).Sub(n)
+}
+
+// Returns the negative value of Money. Neg modifies and returns m (see
+// Negated for a version that leaves m unchanged).
 func (m *Money) Neg() *Money {
 	if m.M != 0 {
 		m.M *= -1
@@ -160,50 +776,110 @@ func (m *Money) Neg() *Money {
 	return m
 }
 
+// Returns the negative value of m as a new Money, leaving m unchanged.
+// It panics with ErrMoneyOverflow for the most negative amount.
+func (m *Money) Negated() *Money {
+	if m.M == math.MinInt64 {
+		panic(ErrMoneyOverflow)
+	}
+	return m.withAmount(-m.M)
+}
+
+// Adds m and n and returns the result as a new Money, leaving
+// both m and n unchanged. The currency is taken from m.
+func (m *Money) Plus(n *Money) *Money {
+	return m.withAmount(m.M).Add(n)
+}
+
 // Sets the Money field M.
 func (m *Money) Set(x int64) *Money {
 	m.M = x
 	return m
 }
 
-// Sets the currency of Money.
+// Sets the currency of Money. M is kept in minor units, but gets the
+// decimal places of the new currency like in New, e.g. 1234 for 12.34
+// USD is 1234 yen for JPY. The amount is not converted.
 func (m *Money) SetCurrency(currency string) *Money {
 	m.C = currency
+	m.dp = newScale(currency)
 	return m
 }
 
-// Sets the currency of Money by locale.
+// Sets the currency of Money by locale (see SetCurrency).
 func (m *Money) SetCurrencyByLocale(lce string) *Money {
 	l := locale.Get(lce)
 	if l != nil {
-		m.C = l.CurrencyCode
+		m.SetCurrency(l.CurrencyCode)
 	}
 
 	return m
 }
 
-// Sets the Money fields M and C.
+// Sets the Money fields M and C. Like in New, x is given in the minor
+// unit of the currency.
 func (m *Money) Setc(x int64, currency string) *Money {
 	m.M = x
-	m.C = currency
-	return m
+	return m.SetCurrency(currency)
 }
 
 // Sets a float64 into a Money type for precision calculations.
+// The amount is rounded with the package-wide rounding mode, see SetfMode.
+// Setf panics with ErrMoneyNotFinite if f is NaN or infinite and with
+// ErrMoneyOverflow if the amount overflows (see SetfErr for a
+// non-panicking version).
 func (m *Money) Setf(f float64) *Money {
-	fDPf := f * DPf
-	r := int64(f * DPf)
-	return m.Set(Rnd(r, fDPf-float64(r)))
+	if _, err := m.SetfErr(f); err != nil {
+		panic(err)
+	}
+	return m
 }
 
-// Sets a float64 into a Money type for precision calculations.
+// Sets a float64 into a Money type for precision calculations, rounded
+// to the decimal places of the currency like in New. Setfc panics like
+// Setf and leaves m unchanged then.
 func (m *Money) Setfc(f float64, currency string) *Money {
-	fDPf := f * DPf
-	r := int64(f * DPf)
-	return m.Setc(Rnd(r, fDPf-float64(r)), currency)
+	n := &Money{C: currency, dp: newScale(currency)}
+	n.Setf(f)
+	*m = *n
+	return m
+}
+
+// Sets a float64 into a Money type like Setf, but returns
+// ErrMoneyNotFinite or ErrMoneyOverflow instead of panicking.
+// m is left unchanged on error.
+func (m *Money) SetfErr(f float64) (*Money, error) {
+	return m.setf(f, GetRoundingMode())
+}
+
+// Sets a float64 into a Money type like Setf, but rounds with the given
+// rounding mode instead of the package-wide one, e.g. 0.125 is set as
+// 0.13 with HalfUp and as 0.12 with HalfEven. The float is taken as the
+// shortest decimal that converts to it, so 2.675 is 2.68 with HalfUp even
+// though its binary value is slightly less. SetfMode panics like Setf.
+func (m *Money) SetfMode(f float64, mode RoundingMode) *Money {
+	if _, err := m.setf(f, mode); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Sets f rounded with mode into m, or returns ErrMoneyNotFinite or
+// ErrMoneyOverflow leaving m unchanged.
+func (m *Money) setf(f float64, mode RoundingMode) (*Money, error) {
+	x, err := decimalRat(f)
+	if err != nil {
+		return nil, err
+	}
+	r, err := mode.roundRat(x.Mul(x, new(big.Rat).SetInt64(m.scale())))
+	if err != nil {
+		return nil, err
+	}
+	return m.Set(r), nil
 }
 
 // Returns the Sign of Money 1 if positive, -1 if negative.
+// For compatibility, Sign returns 1 for zero (see Signum).
 func (m *Money) Sign() int {
 	if m.M < 0 {
 		return -1
@@ -211,13 +887,52 @@ func (m *Money) Sign() int {
 	return 1
 }
 
+// Returns 1 if the amount is positive, -1 if it is negative and 0 if it
+// is zero.
+func (m *Money) Signum() int {
+	switch {
+	case m.M < 0:
+		return -1
+	case m.M > 0:
+		return 1
+	}
+	return 0
+}
+
 // String for money type representation in basic monetary unit (DOLLARS CENTS).
+// The amount has the decimal places of m, which are the decimal digits of
+// the currency for New, e.g. "1234 JPY", "12.34 USD" or "1.234 BHD", and
+// those of the package-wide DP for an unknown currency.
 func (m *Money) String() string {
-	if m.Sign() > 0 {
-		return fmt.Sprintf("%d.%02d %s", m.Value()/DP, m.Value()%DP, m.C)
+	dp := m.scale()
+	return fmt.Sprintf("%s %s", formatDecimal(m.M, dp, decimalPlaces(dp)), m.C)
+}
+
+// Returns the amount of m like String, but prefixed with the currency
+// symbol instead of followed by the code, e.g. "$1234.56" for USD,
+// "-$1234.56" for negative amounts or "¥1234" for JPY. It does not use
+// any locale. If the currency has no symbol, String is returned.
+func (m *Money) StringSymbol() string {
+	symbol := m.symbol(SymbolDefault)
+	if symbol == "" || symbol == m.C {
+		return m.String()
+	}
+	dp := m.scale()
+	s := formatDecimal(m.M, dp, decimalPlaces(dp))
+	if m.M < 0 {
+		return "-" + symbol + s[1:]
 	}
-	// Negative value
-	return fmt.Sprintf("-%d.%02d %s", m.Abs().Value()/DP, m.Abs().Value()%DP, m.C)
+	return symbol + s
+}
+
+// Returns the exact amount of m as a decimal string without currency,
+// symbol or grouping, e.g. "1234.56" for USD, "1234" for JPY or
+// "-1.234" for BHD. The amount has at least the decimal digits of the
+// currency (those of m if the currency is unknown) and more if needed to
+// represent it exactly, e.g. "1234.567" for NewWithScale(1234567, "USD",
+// 3). The result can be parsed by NewFromString.
+func (m *Money) Decimal() string {
+	return formatDecimal(m.M, m.scale(), m.serializedDigits())
 }
 
 func (m *Money) Format(loc string) string {
@@ -227,101 +942,120 @@ func (m *Money) Format(loc string) string {
 		// we'll try our best to display something useful.
 		return m.String()
 	}
+	return m.format(l, FormatOptions{})
+}
 
-	// DP is a measure for decimals: 2 decimal digits => dp = 10^2
-	currencySymbol := m.C
-	curr := currency.Get(m.C)
-	if curr != nil {
-		currencySymbol = curr.Symbol
-	}
-
-	// DP is a measure for decimals: 2 decimal digits => dp = 10^2
-	dp := int64(math.Pow10(l.CurrencyDecimalDigits))
-
-	// Group DP is a measure for grouping: 3 decimal digits => groupDp = 10^3
-	groupSize := 3
-	if len(l.CurrencyGroupSizes) >= 1 {
-		// BUG(oe): Handle currency group size
-		groupSize = l.CurrencyGroupSizes[0]
+// Formats m like Format, but in accounting style: negative values are
+// wrapped in parentheses instead of using the negative pattern of the
+// locale, e.g. "($1,234.56)" instead of "-$1,234.56".
+func (m *Money) FormatAccounting(loc string) string {
+	l := locale.Get(loc)
+	if l == nil {
+		return m.String()
 	}
-	groupDp := int64(math.Pow10(groupSize))
+	return m.format(l, FormatOptions{NegativeStyle: NegativeParentheses})
+}
 
-	// We use absolute values (as int64) from here on, because the
-	// negative sign is part of the currency format pattern.
-	absVal := m.Value()
-	if m.Sign() < 0 {
-		absVal = -absVal
+// Formats m like Format, but with the ISO 4217 currency code in place of
+// the currency symbol, e.g. "USD 1,234.56" for en_US or "1.234,56 EUR"
+// for de_DE. The code is always separated from the number by a space.
+func (m *Money) FormatWithCode(loc string) string {
+	l := locale.Get(loc)
+	if l == nil {
+		return m.String()
 	}
-	wholeVal := absVal / dp
-	decVal := absVal % dp
+	return m.format(l, FormatOptions{SymbolMode: SymbolCode})
+}
 
-	// The unformatted string (without grouping and with a decimal sep of ".")
-	var unformatted string
-	if l.CurrencyDecimalDigits > 0 {
-		unformatted = fmt.Sprintf("%d.%0"+fmt.Sprintf("%d", l.CurrencyDecimalDigits)+"d", wholeVal, decVal)
-	} else {
-		unformatted = fmt.Sprintf("%d", wholeVal)
-	}
-
-	// Perform grouping operation of the whole number
-	groups := make([]string, 0)
-	inner_group_fmt := "%0" + fmt.Sprintf("%d", groupSize) + "d"
-	for {
-		group := wholeVal%groupDp
-		var s string
-		if wholeVal < groupDp {
-			s = fmt.Sprintf("%d", group)
-		} else {
-			s = fmt.Sprintf(inner_group_fmt, group)
-		}
-		groups = append(groups, s)
-		wholeVal /= groupDp
-		if wholeVal == 0 {
-			break
-		}
-	}
-	var wholeBuf bytes.Buffer
-	for i, _ := range groups {
-		if i > 0 {
-			wholeBuf.WriteString(l.CurrencyGroupSeparator)
-		}
-		wholeBuf.WriteString(groups[len(groups)-i-1])
+// Formats m like Format, but without the currency symbol, e.g. "1,234.56"
+// or "(1,234.56)" for en_US. The negative pattern, decimal digits and
+// separators of the locale are still applied.
+func (m *Money) FormatNumber(loc string) string {
+	l := locale.Get(loc)
+	if l == nil {
+		return formatDecimal(m.M, m.scale(), decimalPlaces(m.scale()))
 	}
+	return m.format(l, FormatOptions{SymbolMode: SymbolNone})
+}
 
-	// Which pattern do we need?
-	// Notice that the minus sign is part of the pattern
-	var pattern string
-	if m.Sign() > 0 {
-		pattern = l.CurrencyPositivePattern
-	} else {
-		pattern = l.CurrencyNegativePattern
-	}
+// Returns the currency symbol of m selected by mode, or its currency code
+// if the currency is unknown.
+func (m *Money) symbol(mode SymbolMode) string {
+	return symbolOf(currency.Get(m.C), m.C, mode)
+}
 
-	// Split into whole and decimal and build formatted number
-	var formatted string
-	parts := strings.SplitN(unformatted, ".", 2)
-	if len(parts) > 1 {
-		formatted = fmt.Sprintf("%s%s%s", wholeBuf.String(), l.CurrencyDecimalSeparator, parts[1])
-	} else {
-		formatted = wholeBuf.String()
+// Returns the symbol of the currency c with the code for the symbol mode,
+// or the code if c is nil (unknown).
+func symbolOf(c *currency.Currency, code string, mode SymbolMode) string {
+	switch {
+	case c == nil:
+		return code
+	case mode == SymbolNarrow && c.NarrowSymbol != "":
+		return c.NarrowSymbol
+	case mode == SymbolFull && c.FullSymbol != "":
+		return c.FullSymbol
 	}
+	return c.Symbol
+}
 
-	output := strings.Replace(pattern, "$", currencySymbol, -1)
-	output = strings.Replace(output, "n", formatted, -1)
+// Formats m with the locale l and the options opts (see newFormatter).
+func (m *Money) format(l *locale.Locale, opts FormatOptions) string {
+	return newFormatter(l, opts).Format(m)
+}
 
-	return output
+// Returns the amount x with decimal factor dp rounded with the
+// package-wide rounding mode to the given number of decimal places.
+func roundDigits(x, dp int64, digits int) int64 {
+	r := new(big.Rat).SetFrac(big.NewInt(x), big.NewInt(dp))
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)))
+	v, err := GetRoundingMode().roundRat(r)
+	if err != nil {
+		panic(err)
+	}
+	return v
 }
 
-// Subtracts one Money type from another.
+// Subtracts one Money type from another. Sub modifies and returns m
+// (see Minus for a version that leaves m unchanged). It panics if the
+// currencies differ.
 func (m *Money) Sub(n *Money) *Money {
-	r := m.M - n.M
-	if (r^m.M)&^(r^n.M) < 0 {
-		panic(ErrMoneyOverflow)
+	if _, err := m.SubErr(n); err != nil {
+		panic(err)
 	}
-	m.M = r
 	return m
 }
 
+// Subtracts one Money type from another like Sub, but returns
+// ErrMoneyOverflow or ErrCurrencyMismatch instead of panicking.
+// m is left unchanged on error.
+func (m *Money) SubErr(n *Money) (*Money, error) {
+	c, err := commonCurrency(m, n)
+	if err != nil {
+		return nil, err
+	}
+	a, b, dp, err := align(m, n)
+	if err != nil {
+		return nil, err
+	}
+	r := a - b
+	if (r^a)&^(r^b) < 0 {
+		return nil, ErrMoneyOverflow
+	}
+	if dp != m.scale() {
+		m.dp = dp
+	}
+	m.M = r
+	m.C = c
+	return m, nil
+}
+
+// Multiplies m and n and returns the result as a new Money, leaving
+// both m and n unchanged. The currency is taken from m. The product is
+// rounded like Mul.
+func (m *Money) Times(n *Money) *Money {
+	return m.withAmount(m.M).Mul(n)
+}
+
 // Returns in int64 the value of Money (also see Gett(), See Get() for float64).
 func (m *Money) Value() int64 {
 	return m.M
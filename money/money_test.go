@@ -2,7 +2,11 @@ package money
 
 import (
 	"fmt"
+	"math"
+	"sync"
 	"testing"
+
+	"github.com/hailocab/i18n-go/currency"
 )
 
 func TestM(t *testing.T) {
@@ -11,31 +15,31 @@ func TestM(t *testing.T) {
 		t.Errorf("expected money amount to be %v, got %v", 0, m.M)
 	}
 
-	m = &Money{-123, "EUR"}
+	m = &Money{M: -123, C: "EUR"}
 	if m.M != -123 {
 		t.Errorf("expected money amount to be %v, got %v", -123, m.M)
 	}
 
-	m = &Money{123, "EUR"}
+	m = &Money{M: 123, C: "EUR"}
 	if m.M != 123 {
 		t.Errorf("expected money amount to be %v, got %v", 123, m.M)
 	}
 }
 
 func TestGet(t *testing.T) {
-	m := &Money{123, "EUR"}
+	m := &Money{M: 123, C: "EUR"}
 	if m.Get() != 1.23 {
 		t.Errorf("expected money amount to be %v, got %v", 1.23, m.Get())
 	}
 
-	m = &Money{12345, "EUR"}
+	m = &Money{M: 12345, C: "EUR"}
 	if m.Get() != 123.45 {
 		t.Errorf("expected money amount to be %v, got %v", 123.45, m.Get())
 	}
 }
 
 func TestSet(t *testing.T) {
-	m := &Money{123, "EUR"}
+	m := &Money{M: 123, C: "EUR"}
 	if m.Get() != 1.23 {
 		t.Errorf("expected money amount to be %v, got %v", 1.23, m.Get())
 	}
@@ -46,7 +50,7 @@ func TestSet(t *testing.T) {
 }
 
 func TestSetf(t *testing.T) {
-	m := &Money{123, "EUR"}
+	m := &Money{M: 123, C: "EUR"}
 	if m.Get() != 1.23 {
 		t.Errorf("expected money amount to be %v, got %v", 1.23, m.Get())
 	}
@@ -57,8 +61,8 @@ func TestSetf(t *testing.T) {
 }
 
 func TestAdd(t *testing.T) {
-	m1 := &Money{123, "EUR"}
-	m2 := &Money{678, "EUR"}
+	m1 := &Money{M: 123, C: "EUR"}
+	m2 := &Money{M: 678, C: "EUR"}
 	m3 := m1.Add(m2)
 	if m3.Get() != 8.01 {
 		t.Errorf("expected money amount to be %v, got %v", 8.01, m3.Get())
@@ -66,8 +70,8 @@ func TestAdd(t *testing.T) {
 }
 
 func TestMul(t *testing.T) {
-	m1 := &Money{123, "EUR"}
-	m2 := &Money{200, "EUR"}
+	m1 := &Money{M: 123, C: "EUR"}
+	m2 := &Money{M: 200, C: "EUR"}
 	m3 := m1.Mul(m2)
 	if m3.Get() != 2.46 {
 		t.Errorf("expected money amount to be %v, got %v", 2.46, m3.Get())
@@ -75,7 +79,7 @@ func TestMul(t *testing.T) {
 }
 
 func TestMulf(t *testing.T) {
-	m1 := &Money{123, "EUR"}
+	m1 := &Money{M: 123, C: "EUR"}
 	m2 := 2.0
 	m3 := m1.Mulf(m2)
 	if m3.Get() != 2.46 {
@@ -83,26 +87,65 @@ func TestMulf(t *testing.T) {
 	}
 }
 
+// Floats are taken as their shortest decimal everywhere, not as their
+// exact binary value, e.g. 1.005 is slightly less than 1.005 in binary.
+func TestFloatsAsDecimals(t *testing.T) {
+	defer SetRoundingMode(HalfUp)
+
+	var fixtures = []struct {
+		mode     RoundingMode
+		f        float64
+		expected int64
+	}{
+		{HalfUp, 1.005, 101},
+		{HalfUp, 0.015, 2},
+		{HalfEven, 0.005, 0},
+		{HalfEven, 0.015, 2},
+	}
+
+	for i, f := range fixtures {
+		SetRoundingMode(f.mode)
+		one := New(100, "USD")
+		rate, _ := one.Convert(Rate{"USD", "EUR", f.f})
+		for j, got := range []*Money{
+			NewFromFloat(f.f, "USD"),
+			New(0, "USD").Setf(f.f),
+			one.Copy().Mulf(f.f),
+			New(10000, "USD").Percent(f.f),
+			rate,
+		} {
+			if got.M != f.expected {
+				t.Errorf("%d.%d. expected %v, got %v", i, j, f.expected, got.M)
+			}
+		}
+	}
+
+	SetRoundingMode(HalfEven)
+	if got := New(5, "USD").Mulf(0.1); got.M != 0 {
+		t.Errorf("expected %v, got %v", 0, got.M)
+	}
+}
+
 func TestMoneyStringer(t *testing.T) {
 	var fixtures = []struct {
 		m        *Money
 		expected string
 	}{
-		{&Money{0, "EUR"}, "0.00 EUR"},
-		{&Money{1, "EUR"}, "0.01 EUR"},
-		{&Money{12, "EUR"}, "0.12 EUR"},
-		{&Money{123, "EUR"}, "1.23 EUR"},
-		{&Money{1234, "EUR"}, "12.34 EUR"},
-		{&Money{123456, "EUR"}, "1234.56 EUR"},
-		{&Money{1234567, "EUR"}, "12345.67 EUR"},
-		{&Money{1234567890, "EUR"}, "12345678.90 EUR"},
-		{&Money{-1, "EUR"}, "-0.01 EUR"},
-		{&Money{-12, "EUR"}, "-0.12 EUR"},
-		{&Money{-123, "EUR"}, "-1.23 EUR"},
-		{&Money{-1234, "EUR"}, "-12.34 EUR"},
-		{&Money{-123456, "EUR"}, "-1234.56 EUR"},
-		{&Money{-1234567, "EUR"}, "-12345.67 EUR"},
-		{&Money{-1234567890, "EUR"}, "-12345678.90 EUR"},
+		{&Money{M: 0, C: "EUR"}, "0.00 EUR"},
+		{&Money{M: 1, C: "EUR"}, "0.01 EUR"},
+		{&Money{M: 12, C: "EUR"}, "0.12 EUR"},
+		{&Money{M: 123, C: "EUR"}, "1.23 EUR"},
+		{&Money{M: 1234, C: "EUR"}, "12.34 EUR"},
+		{&Money{M: 123456, C: "EUR"}, "1234.56 EUR"},
+		{&Money{M: 1234567, C: "EUR"}, "12345.67 EUR"},
+		{&Money{M: 1234567890, C: "EUR"}, "12345678.90 EUR"},
+		{&Money{M: -1, C: "EUR"}, "-0.01 EUR"},
+		{&Money{M: -12, C: "EUR"}, "-0.12 EUR"},
+		{&Money{M: -123, C: "EUR"}, "-1.23 EUR"},
+		{&Money{M: -1234, C: "EUR"}, "-12.34 EUR"},
+		{&Money{M: -123456, C: "EUR"}, "-1234.56 EUR"},
+		{&Money{M: -1234567, C: "EUR"}, "-12345.67 EUR"},
+		{&Money{M: -1234567890, C: "EUR"}, "-12345678.90 EUR"},
 	}
 
 	for _, f := range fixtures {
@@ -119,153 +162,153 @@ func TestMoneyFormat(t *testing.T) {
 		locale   string
 		expected string
 	}{
-		{&Money{0, "EUR"}, "de", "0.00 EUR"},
-		{&Money{1, "EUR"}, "de", "0.01 EUR"},
-		{&Money{12, "EUR"}, "de", "0.12 EUR"},
-		{&Money{123, "EUR"}, "de", "1.23 EUR"},
-		{&Money{1234, "EUR"}, "de", "12.34 EUR"},
-		{&Money{123456, "EUR"}, "de", "1234.56 EUR"},
-		{&Money{1234567, "EUR"}, "de", "12345.67 EUR"},
-		{&Money{1234567890, "EUR"}, "de", "12345678.90 EUR"},
-		{&Money{-1, "EUR"}, "de", "-0.01 EUR"},
-		{&Money{-12, "EUR"}, "de", "-0.12 EUR"},
-		{&Money{-123, "EUR"}, "de", "-1.23 EUR"},
-		{&Money{-1234, "EUR"}, "de", "-12.34 EUR"},
-		{&Money{-123456, "EUR"}, "de", "-1234.56 EUR"},
-		{&Money{-1234567, "EUR"}, "de", "-12345.67 EUR"},
-		{&Money{-1234567890, "EUR"}, "de", "-12345678.90 EUR"},
-
-		{&Money{0, "EUR"}, "de_DE", "0,00 €"},
-		{&Money{1, "EUR"}, "de_DE", "0,01 €"},
-		{&Money{12, "EUR"}, "de_DE", "0,12 €"},
-		{&Money{123, "EUR"}, "de_DE", "1,23 €"},
-		{&Money{1234, "EUR"}, "de_DE", "12,34 €"},
-		{&Money{123456, "EUR"}, "de_DE", "1.234,56 €"},
-		{&Money{1234567, "EUR"}, "de_DE", "12.345,67 €"},
-		{&Money{1234567890, "EUR"}, "de_DE", "12.345.678,90 €"},
-		{&Money{-1, "EUR"}, "de_DE", "-0,01 €"},
-		{&Money{-12, "EUR"}, "de_DE", "-0,12 €"},
-		{&Money{-123, "EUR"}, "de_DE", "-1,23 €"},
-		{&Money{-1234, "EUR"}, "de_DE", "-12,34 €"},
-		{&Money{-123456, "EUR"}, "de_DE", "-1.234,56 €"},
-		{&Money{-1234567, "EUR"}, "de_DE", "-12.345,67 €"},
-		{&Money{-1234567890, "EUR"}, "de_DE", "-12.345.678,90 €"},
-
-		{&Money{0, "EUR"}, "de_AT", "€ 0,00"},
-		{&Money{1, "EUR"}, "de_AT", "€ 0,01"},
-		{&Money{12, "EUR"}, "de_AT", "€ 0,12"},
-		{&Money{123, "EUR"}, "de_AT", "€ 1,23"},
-		{&Money{1234, "EUR"}, "de_AT", "€ 12,34"},
-		{&Money{123456, "EUR"}, "de_AT", "€ 1.234,56"},
-		{&Money{1234567, "EUR"}, "de_AT", "€ 12.345,67"},
-		{&Money{1234567890, "EUR"}, "de_AT", "€ 12.345.678,90"},
-		{&Money{-1, "EUR"}, "de_AT", "-€ 0,01"},
-		{&Money{-12, "EUR"}, "de_AT", "-€ 0,12"},
-		{&Money{-123, "EUR"}, "de_AT", "-€ 1,23"},
-		{&Money{-1234, "EUR"}, "de_AT", "-€ 12,34"},
-		{&Money{-123456, "EUR"}, "de_AT", "-€ 1.234,56"},
-		{&Money{-1234567, "EUR"}, "de_AT", "-€ 12.345,67"},
-		{&Money{-1234567890, "EUR"}, "de_AT", "-€ 12.345.678,90"},
-
-		{&Money{0, "EUR"}, "de_CH", "€ 0.00"},
-		{&Money{1, "EUR"}, "de_CH", "€ 0.01"},
-		{&Money{12, "EUR"}, "de_CH", "€ 0.12"},
-		{&Money{123, "EUR"}, "de_CH", "€ 1.23"},
-		{&Money{1234, "EUR"}, "de_CH", "€ 12.34"},
-		{&Money{123456, "EUR"}, "de_CH", "€ 1'234.56"},
-		{&Money{1234567, "EUR"}, "de_CH", "€ 12'345.67"},
-		{&Money{1234567890, "EUR"}, "de_CH", "€ 12'345'678.90"},
-		{&Money{-1, "EUR"}, "de_CH", "€-0.01"},
-		{&Money{-12, "EUR"}, "de_CH", "€-0.12"},
-		{&Money{-123, "EUR"}, "de_CH", "€-1.23"},
-		{&Money{-1234, "EUR"}, "de_CH", "€-12.34"},
-		{&Money{-123456, "EUR"}, "de_CH", "€-1'234.56"},
-		{&Money{-1234567, "EUR"}, "de_CH", "€-12'345.67"},
-		{&Money{-1234567890, "EUR"}, "de_CH", "€-12'345'678.90"},
-
-		{&Money{0, "EUR"}, "en", "0.00 EUR"},
-		{&Money{1, "EUR"}, "en", "0.01 EUR"},
-		{&Money{12, "EUR"}, "en", "0.12 EUR"},
-		{&Money{123, "EUR"}, "en", "1.23 EUR"},
-		{&Money{1234, "EUR"}, "en", "12.34 EUR"},
-		{&Money{123456, "EUR"}, "en", "1234.56 EUR"},
-		{&Money{1234567, "EUR"}, "en", "12345.67 EUR"},
-		{&Money{1234567890, "EUR"}, "en", "12345678.90 EUR"},
-		{&Money{-1, "EUR"}, "en", "-0.01 EUR"},
-		{&Money{-12, "EUR"}, "en", "-0.12 EUR"},
-		{&Money{-123, "EUR"}, "en", "-1.23 EUR"},
-		{&Money{-1234, "EUR"}, "en", "-12.34 EUR"},
-		{&Money{-123456, "EUR"}, "en", "-1234.56 EUR"},
-		{&Money{-1234567, "EUR"}, "en", "-12345.67 EUR"},
-		{&Money{-1234567890, "EUR"}, "en", "-12345678.90 EUR"},
-
-		{&Money{0, "EUR"}, "en_US", "€0.00"},
-		{&Money{1, "EUR"}, "en_US", "€0.01"},
-		{&Money{12, "EUR"}, "en_US", "€0.12"},
-		{&Money{123, "EUR"}, "en_US", "€1.23"},
-		{&Money{1234, "EUR"}, "en_US", "€12.34"},
-		{&Money{123456, "EUR"}, "en_US", "€1,234.56"},
-		{&Money{1234567, "EUR"}, "en_US", "€12,345.67"},
-		{&Money{1234567890, "EUR"}, "en_US", "€12,345,678.90"},
-		{&Money{-1, "EUR"}, "en_US", "(€0.01)"},
-		{&Money{-12, "EUR"}, "en_US", "(€0.12)"},
-		{&Money{-123, "EUR"}, "en_US", "(€1.23)"},
-		{&Money{-1234, "EUR"}, "en_US", "(€12.34)"},
-		{&Money{-123456, "EUR"}, "en_US", "(€1,234.56)"},
-		{&Money{-1234567, "EUR"}, "en_US", "(€12,345.67)"},
-		{&Money{-1234567890, "EUR"}, "en_US", "(€12,345,678.90)"},
-
-		{&Money{0, "EUR"}, "fr", "0.00 EUR"},
-		{&Money{1, "EUR"}, "fr", "0.01 EUR"},
-		{&Money{12, "EUR"}, "fr", "0.12 EUR"},
-		{&Money{123, "EUR"}, "fr", "1.23 EUR"},
-		{&Money{1234, "EUR"}, "fr", "12.34 EUR"},
-		{&Money{123456, "EUR"}, "fr", "1234.56 EUR"},
-		{&Money{1234567, "EUR"}, "fr", "12345.67 EUR"},
-		{&Money{1234567890, "EUR"}, "fr", "12345678.90 EUR"},
-		{&Money{-1, "EUR"}, "fr", "-0.01 EUR"},
-		{&Money{-12, "EUR"}, "fr", "-0.12 EUR"},
-		{&Money{-123, "EUR"}, "fr", "-1.23 EUR"},
-		{&Money{-1234, "EUR"}, "fr", "-12.34 EUR"},
-		{&Money{-123456, "EUR"}, "fr", "-1234.56 EUR"},
-		{&Money{-1234567, "EUR"}, "fr", "-12345.67 EUR"},
-		{&Money{-1234567890, "EUR"}, "fr", "-12345678.90 EUR"},
-
-		{&Money{1234567890, "USD"}, "en_US", "$12,345,678.90"},
-		{&Money{-1234567890, "USD"}, "en_US", "($12,345,678.90)"},
-		{&Money{1234567890, "USD"}, "de_DE", "12.345.678,90 $"},
-		{&Money{-1234567890, "USD"}, "de_DE", "-12.345.678,90 $"},
-		{&Money{1234567890, "USD"}, "de_CH", "$ 12'345'678.90"},
-		{&Money{-1234567890, "USD"}, "de_CH", "$-12'345'678.90"},
-		{&Money{1234567890, "GBP"}, "en_GB", "£12,345,678.90"},
-		{&Money{-1234567890, "GBP"}, "en_GB", "-£12,345,678.90"},
-		{&Money{1234567890, "GBP"}, "de_DE", "12.345.678,90 £"},
-		{&Money{-1234567890, "GBP"}, "de_DE", "-12.345.678,90 £"},
-		{&Money{1234567890, "GBP"}, "de_CH", "£ 12'345'678.90"},
-		{&Money{-1234567890, "GBP"}, "de_CH", "£-12'345'678.90"},
-		{&Money{1234567890, "HUF"}, "hu_HU", "12 345 678,90 Ft"},
-		{&Money{-1234567890, "HUF"}, "hu_HU", "-12 345 678,90 Ft"},
-		{&Money{1234567890, "HUF"}, "de_DE", "12.345.678,90 Ft"},
-		{&Money{-1234567890, "HUF"}, "de_DE", "-12.345.678,90 Ft"},
-		{&Money{1234567890, "HUF"}, "de_CH", "Ft 12'345'678.90"},
-		{&Money{-1234567890, "HUF"}, "de_CH", "Ft-12'345'678.90"},
-		{&Money{1234567890, "JPY"}, "ja_JP", "¥1,234,567,890"},
-		{&Money{-1234567890, "JPY"}, "ja_JP", "-¥1,234,567,890"},
-		{&Money{1234567890, "JPY"}, "de_DE", "12.345.678,90 ¥"},
-		{&Money{-1234567890, "JPY"}, "de_DE", "-12.345.678,90 ¥"},
-		{&Money{1234567890, "JPY"}, "de_CH", "¥ 12'345'678.90"},
-		{&Money{-1234567890, "JPY"}, "de_CH", "¥-12'345'678.90"},
-		{&Money{1234567890, "SEK"}, "se_SE", "12.345.678,90 kr"},
-		{&Money{-1234567890, "SEK"}, "se_SE", "-12.345.678,90 kr"},
-		{&Money{1234567890, "SEK"}, "de_DE", "12.345.678,90 kr"},
-		{&Money{-1234567890, "SEK"}, "de_DE", "-12.345.678,90 kr"},
-		{&Money{1234567890, "SEK"}, "de_CH", "kr 12'345'678.90"},
-		{&Money{-1234567890, "SEK"}, "de_CH", "kr-12'345'678.90"},
-
-		{&Money{200000, "ZAR"}, "en_ZA", "R 2 000,00"},
-		{&Money{120000, "ZAR"}, "en_ZA", "R 1 200,00"},
-		{&Money{90000, "ZAR"}, "en_ZA", "R 900,00"},
-		{&Money{90001, "ZAR"}, "en_ZA", "R 900,01"},
+		{&Money{M: 0, C: "EUR"}, "de", "0.00 EUR"},
+		{&Money{M: 1, C: "EUR"}, "de", "0.01 EUR"},
+		{&Money{M: 12, C: "EUR"}, "de", "0.12 EUR"},
+		{&Money{M: 123, C: "EUR"}, "de", "1.23 EUR"},
+		{&Money{M: 1234, C: "EUR"}, "de", "12.34 EUR"},
+		{&Money{M: 123456, C: "EUR"}, "de", "1234.56 EUR"},
+		{&Money{M: 1234567, C: "EUR"}, "de", "12345.67 EUR"},
+		{&Money{M: 1234567890, C: "EUR"}, "de", "12345678.90 EUR"},
+		{&Money{M: -1, C: "EUR"}, "de", "-0.01 EUR"},
+		{&Money{M: -12, C: "EUR"}, "de", "-0.12 EUR"},
+		{&Money{M: -123, C: "EUR"}, "de", "-1.23 EUR"},
+		{&Money{M: -1234, C: "EUR"}, "de", "-12.34 EUR"},
+		{&Money{M: -123456, C: "EUR"}, "de", "-1234.56 EUR"},
+		{&Money{M: -1234567, C: "EUR"}, "de", "-12345.67 EUR"},
+		{&Money{M: -1234567890, C: "EUR"}, "de", "-12345678.90 EUR"},
+
+		{&Money{M: 0, C: "EUR"}, "de_DE", "0,00 €"},
+		{&Money{M: 1, C: "EUR"}, "de_DE", "0,01 €"},
+		{&Money{M: 12, C: "EUR"}, "de_DE", "0,12 €"},
+		{&Money{M: 123, C: "EUR"}, "de_DE", "1,23 €"},
+		{&Money{M: 1234, C: "EUR"}, "de_DE", "12,34 €"},
+		{&Money{M: 123456, C: "EUR"}, "de_DE", "1.234,56 €"},
+		{&Money{M: 1234567, C: "EUR"}, "de_DE", "12.345,67 €"},
+		{&Money{M: 1234567890, C: "EUR"}, "de_DE", "12.345.678,90 €"},
+		{&Money{M: -1, C: "EUR"}, "de_DE", "-0,01 €"},
+		{&Money{M: -12, C: "EUR"}, "de_DE", "-0,12 €"},
+		{&Money{M: -123, C: "EUR"}, "de_DE", "-1,23 €"},
+		{&Money{M: -1234, C: "EUR"}, "de_DE", "-12,34 €"},
+		{&Money{M: -123456, C: "EUR"}, "de_DE", "-1.234,56 €"},
+		{&Money{M: -1234567, C: "EUR"}, "de_DE", "-12.345,67 €"},
+		{&Money{M: -1234567890, C: "EUR"}, "de_DE", "-12.345.678,90 €"},
+
+		{&Money{M: 0, C: "EUR"}, "de_AT", "€ 0,00"},
+		{&Money{M: 1, C: "EUR"}, "de_AT", "€ 0,01"},
+		{&Money{M: 12, C: "EUR"}, "de_AT", "€ 0,12"},
+		{&Money{M: 123, C: "EUR"}, "de_AT", "€ 1,23"},
+		{&Money{M: 1234, C: "EUR"}, "de_AT", "€ 12,34"},
+		{&Money{M: 123456, C: "EUR"}, "de_AT", "€ 1.234,56"},
+		{&Money{M: 1234567, C: "EUR"}, "de_AT", "€ 12.345,67"},
+		{&Money{M: 1234567890, C: "EUR"}, "de_AT", "€ 12.345.678,90"},
+		{&Money{M: -1, C: "EUR"}, "de_AT", "-€ 0,01"},
+		{&Money{M: -12, C: "EUR"}, "de_AT", "-€ 0,12"},
+		{&Money{M: -123, C: "EUR"}, "de_AT", "-€ 1,23"},
+		{&Money{M: -1234, C: "EUR"}, "de_AT", "-€ 12,34"},
+		{&Money{M: -123456, C: "EUR"}, "de_AT", "-€ 1.234,56"},
+		{&Money{M: -1234567, C: "EUR"}, "de_AT", "-€ 12.345,67"},
+		{&Money{M: -1234567890, C: "EUR"}, "de_AT", "-€ 12.345.678,90"},
+
+		{&Money{M: 0, C: "EUR"}, "de_CH", "€ 0.00"},
+		{&Money{M: 1, C: "EUR"}, "de_CH", "€ 0.01"},
+		{&Money{M: 12, C: "EUR"}, "de_CH", "€ 0.12"},
+		{&Money{M: 123, C: "EUR"}, "de_CH", "€ 1.23"},
+		{&Money{M: 1234, C: "EUR"}, "de_CH", "€ 12.34"},
+		{&Money{M: 123456, C: "EUR"}, "de_CH", "€ 1'234.56"},
+		{&Money{M: 1234567, C: "EUR"}, "de_CH", "€ 12'345.67"},
+		{&Money{M: 1234567890, C: "EUR"}, "de_CH", "€ 12'345'678.90"},
+		{&Money{M: -1, C: "EUR"}, "de_CH", "€-0.01"},
+		{&Money{M: -12, C: "EUR"}, "de_CH", "€-0.12"},
+		{&Money{M: -123, C: "EUR"}, "de_CH", "€-1.23"},
+		{&Money{M: -1234, C: "EUR"}, "de_CH", "€-12.34"},
+		{&Money{M: -123456, C: "EUR"}, "de_CH", "€-1'234.56"},
+		{&Money{M: -1234567, C: "EUR"}, "de_CH", "€-12'345.67"},
+		{&Money{M: -1234567890, C: "EUR"}, "de_CH", "€-12'345'678.90"},
+
+		{&Money{M: 0, C: "EUR"}, "en", "0.00 EUR"},
+		{&Money{M: 1, C: "EUR"}, "en", "0.01 EUR"},
+		{&Money{M: 12, C: "EUR"}, "en", "0.12 EUR"},
+		{&Money{M: 123, C: "EUR"}, "en", "1.23 EUR"},
+		{&Money{M: 1234, C: "EUR"}, "en", "12.34 EUR"},
+		{&Money{M: 123456, C: "EUR"}, "en", "1234.56 EUR"},
+		{&Money{M: 1234567, C: "EUR"}, "en", "12345.67 EUR"},
+		{&Money{M: 1234567890, C: "EUR"}, "en", "12345678.90 EUR"},
+		{&Money{M: -1, C: "EUR"}, "en", "-0.01 EUR"},
+		{&Money{M: -12, C: "EUR"}, "en", "-0.12 EUR"},
+		{&Money{M: -123, C: "EUR"}, "en", "-1.23 EUR"},
+		{&Money{M: -1234, C: "EUR"}, "en", "-12.34 EUR"},
+		{&Money{M: -123456, C: "EUR"}, "en", "-1234.56 EUR"},
+		{&Money{M: -1234567, C: "EUR"}, "en", "-12345.67 EUR"},
+		{&Money{M: -1234567890, C: "EUR"}, "en", "-12345678.90 EUR"},
+
+		{&Money{M: 0, C: "EUR"}, "en_US", "€0.00"},
+		{&Money{M: 1, C: "EUR"}, "en_US", "€0.01"},
+		{&Money{M: 12, C: "EUR"}, "en_US", "€0.12"},
+		{&Money{M: 123, C: "EUR"}, "en_US", "€1.23"},
+		{&Money{M: 1234, C: "EUR"}, "en_US", "€12.34"},
+		{&Money{M: 123456, C: "EUR"}, "en_US", "€1,234.56"},
+		{&Money{M: 1234567, C: "EUR"}, "en_US", "€12,345.67"},
+		{&Money{M: 1234567890, C: "EUR"}, "en_US", "€12,345,678.90"},
+		{&Money{M: -1, C: "EUR"}, "en_US", "(€0.01)"},
+		{&Money{M: -12, C: "EUR"}, "en_US", "(€0.12)"},
+		{&Money{M: -123, C: "EUR"}, "en_US", "(€1.23)"},
+		{&Money{M: -1234, C: "EUR"}, "en_US", "(€12.34)"},
+		{&Money{M: -123456, C: "EUR"}, "en_US", "(€1,234.56)"},
+		{&Money{M: -1234567, C: "EUR"}, "en_US", "(€12,345.67)"},
+		{&Money{M: -1234567890, C: "EUR"}, "en_US", "(€12,345,678.90)"},
+
+		{&Money{M: 0, C: "EUR"}, "fr", "0.00 EUR"},
+		{&Money{M: 1, C: "EUR"}, "fr", "0.01 EUR"},
+		{&Money{M: 12, C: "EUR"}, "fr", "0.12 EUR"},
+		{&Money{M: 123, C: "EUR"}, "fr", "1.23 EUR"},
+		{&Money{M: 1234, C: "EUR"}, "fr", "12.34 EUR"},
+		{&Money{M: 123456, C: "EUR"}, "fr", "1234.56 EUR"},
+		{&Money{M: 1234567, C: "EUR"}, "fr", "12345.67 EUR"},
+		{&Money{M: 1234567890, C: "EUR"}, "fr", "12345678.90 EUR"},
+		{&Money{M: -1, C: "EUR"}, "fr", "-0.01 EUR"},
+		{&Money{M: -12, C: "EUR"}, "fr", "-0.12 EUR"},
+		{&Money{M: -123, C: "EUR"}, "fr", "-1.23 EUR"},
+		{&Money{M: -1234, C: "EUR"}, "fr", "-12.34 EUR"},
+		{&Money{M: -123456, C: "EUR"}, "fr", "-1234.56 EUR"},
+		{&Money{M: -1234567, C: "EUR"}, "fr", "-12345.67 EUR"},
+		{&Money{M: -1234567890, C: "EUR"}, "fr", "-12345678.90 EUR"},
+
+		{&Money{M: 1234567890, C: "USD"}, "en_US", "$12,345,678.90"},
+		{&Money{M: -1234567890, C: "USD"}, "en_US", "($12,345,678.90)"},
+		{&Money{M: 1234567890, C: "USD"}, "de_DE", "12.345.678,90 $"},
+		{&Money{M: -1234567890, C: "USD"}, "de_DE", "-12.345.678,90 $"},
+		{&Money{M: 1234567890, C: "USD"}, "de_CH", "$ 12'345'678.90"},
+		{&Money{M: -1234567890, C: "USD"}, "de_CH", "$-12'345'678.90"},
+		{&Money{M: 1234567890, C: "GBP"}, "en_GB", "£12,345,678.90"},
+		{&Money{M: -1234567890, C: "GBP"}, "en_GB", "-£12,345,678.90"},
+		{&Money{M: 1234567890, C: "GBP"}, "de_DE", "12.345.678,90 £"},
+		{&Money{M: -1234567890, C: "GBP"}, "de_DE", "-12.345.678,90 £"},
+		{&Money{M: 1234567890, C: "GBP"}, "de_CH", "£ 12'345'678.90"},
+		{&Money{M: -1234567890, C: "GBP"}, "de_CH", "£-12'345'678.90"},
+		{&Money{M: 1234567890, C: "HUF"}, "hu_HU", "12 345 678,90 Ft"},
+		{&Money{M: -1234567890, C: "HUF"}, "hu_HU", "-12 345 678,90 Ft"},
+		{&Money{M: 1234567890, C: "HUF"}, "de_DE", "12.345.678,90 Ft"},
+		{&Money{M: -1234567890, C: "HUF"}, "de_DE", "-12.345.678,90 Ft"},
+		{&Money{M: 1234567890, C: "HUF"}, "de_CH", "Ft 12'345'678.90"},
+		{&Money{M: -1234567890, C: "HUF"}, "de_CH", "Ft-12'345'678.90"},
+		{&Money{M: 1234567890, C: "JPY"}, "ja_JP", "¥1,234,567,890"},
+		{&Money{M: -1234567890, C: "JPY"}, "ja_JP", "-¥1,234,567,890"},
+		{&Money{M: 1234567890, C: "JPY"}, "de_DE", "12.345.678,90 ¥"},
+		{&Money{M: -1234567890, C: "JPY"}, "de_DE", "-12.345.678,90 ¥"},
+		{&Money{M: 1234567890, C: "JPY"}, "de_CH", "¥ 12'345'678.90"},
+		{&Money{M: -1234567890, C: "JPY"}, "de_CH", "¥-12'345'678.90"},
+		{&Money{M: 1234567890, C: "SEK"}, "se_SE", "12.345.678,90 kr"},
+		{&Money{M: -1234567890, C: "SEK"}, "se_SE", "\u221212.345.678,90 kr"},
+		{&Money{M: 1234567890, C: "SEK"}, "de_DE", "12.345.678,90 kr"},
+		{&Money{M: -1234567890, C: "SEK"}, "de_DE", "-12.345.678,90 kr"},
+		{&Money{M: 1234567890, C: "SEK"}, "de_CH", "kr 12'345'678.90"},
+		{&Money{M: -1234567890, C: "SEK"}, "de_CH", "kr-12'345'678.90"},
+
+		{&Money{M: 200000, C: "ZAR"}, "en_ZA", "R 2 000,00"},
+		{&Money{M: 120000, C: "ZAR"}, "en_ZA", "R 1 200,00"},
+		{&Money{M: 90000, C: "ZAR"}, "en_ZA", "R 900,00"},
+		{&Money{M: 90001, C: "ZAR"}, "en_ZA", "R 900,01"},
 	}
 
 	for _, f := range fixtures {
@@ -275,3 +318,1442 @@ func TestMoneyFormat(t *testing.T) {
 		}
 	}
 }
+
+func TestPlusMinusTimes(t *testing.T) {
+	m := &Money{M: 123, C: "EUR"}
+	n := &Money{M: 200, C: "EUR"}
+
+	if got := m.Plus(n); got.M != 323 || got.C != "EUR" {
+		t.Errorf("expected Plus to be %v, got %v", "3.23 EUR", got)
+	}
+	if got := m.Minus(n); got.M != -77 || got.C != "EUR" {
+		t.Errorf("expected Minus to be %v, got %v", "-0.77 EUR", got)
+	}
+	if got := m.Times(n); got.M != 246 || got.C != "EUR" {
+		t.Errorf("expected Times to be %v, got %v", "2.46 EUR", got)
+	}
+
+	if m.M != 123 {
+		t.Errorf("expected receiver to be unchanged, got %v", m.M)
+	}
+	if n.M != 200 {
+		t.Errorf("expected argument to be unchanged, got %v", n.M)
+	}
+}
+
+func TestPlusMinusTimesOverflow(t *testing.T) {
+	var fixtures = []func(){
+		func() { (&Money{M: math.MaxInt64, C: "EUR"}).Plus(&Money{M: 1, C: "EUR"}) },
+		func() { (&Money{M: math.MinInt64, C: "EUR"}).Minus(&Money{M: 1, C: "EUR"}) },
+		func() { (&Money{M: math.MaxInt64, C: "EUR"}).Times(&Money{M: 200, C: "EUR"}) },
+		func() { (&Money{M: math.MinInt64, C: "EUR"}).Times(&Money{M: -100, C: "EUR"}) },
+	}
+
+	for i, f := range fixtures {
+		func() {
+			defer func() {
+				if r := recover(); r != ErrMoneyOverflow {
+					t.Errorf("%d. expected panic with %v, got %v", i, ErrMoneyOverflow, r)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestAddErrSubErr(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		n        *Money
+		sub      bool
+		expected int64
+		err      error
+	}{
+		{&Money{M: 123, C: "EUR"}, &Money{M: 678, C: "EUR"}, false, 801, nil},
+		{&Money{M: math.MaxInt64 - 1, C: "EUR"}, &Money{M: 1, C: "EUR"}, false, math.MaxInt64, nil},
+		{&Money{M: math.MaxInt64, C: "EUR"}, &Money{M: 1, C: "EUR"}, false, math.MaxInt64, ErrMoneyOverflow},
+		{&Money{M: math.MinInt64, C: "EUR"}, &Money{M: -1, C: "EUR"}, false, math.MinInt64, ErrMoneyOverflow},
+		{&Money{M: 123, C: "EUR"}, &Money{M: 678, C: "EUR"}, true, -555, nil},
+		{&Money{M: math.MinInt64 + 1, C: "EUR"}, &Money{M: 1, C: "EUR"}, true, math.MinInt64, nil},
+		{&Money{M: math.MinInt64, C: "EUR"}, &Money{M: 1, C: "EUR"}, true, math.MinInt64, ErrMoneyOverflow},
+		{&Money{M: math.MaxInt64, C: "EUR"}, &Money{M: -1, C: "EUR"}, true, math.MaxInt64, ErrMoneyOverflow},
+	}
+
+	for i, f := range fixtures {
+		var err error
+		if f.sub {
+			_, err = f.m.SubErr(f.n)
+		} else {
+			_, err = f.m.AddErr(f.n)
+		}
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+		if f.m.M != f.expected {
+			t.Errorf("%d. expected money amount to be %v, got %v", i, f.expected, f.m.M)
+		}
+	}
+}
+
+func TestAddSubCurrencyMismatch(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		n        *Money
+		sub      bool
+		expected *Money
+		err      error
+	}{
+		{&Money{M: 100, C: "USD"}, &Money{M: 50, C: "EUR"}, false, &Money{M: 100, C: "USD"}, ErrCurrencyMismatch},
+		{&Money{M: 100, C: "USD"}, &Money{M: 50, C: "EUR"}, true, &Money{M: 100, C: "USD"}, ErrCurrencyMismatch},
+		{&Money{M: 100, C: "USD"}, &Money{M: 50, C: ""}, false, &Money{M: 150, C: "USD"}, nil},
+		{&Money{M: 100, C: "USD"}, &Money{M: 50, C: ""}, true, &Money{M: 50, C: "USD"}, nil},
+		{&Money{M: 100, C: ""}, &Money{M: 50, C: "USD"}, false, &Money{M: 150, C: "USD"}, nil},
+		{&Money{M: 100, C: ""}, &Money{M: 50, C: "USD"}, true, &Money{M: 50, C: "USD"}, nil},
+		{&Money{M: 100, C: ""}, &Money{M: 50, C: ""}, false, &Money{M: 150, C: ""}, nil},
+	}
+
+	for i, f := range fixtures {
+		var err error
+		if f.sub {
+			_, err = f.m.SubErr(f.n)
+		} else {
+			_, err = f.m.AddErr(f.n)
+		}
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+		if f.m.M != f.expected.M || f.m.C != f.expected.C {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, f.m)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r != ErrCurrencyMismatch {
+			t.Errorf("expected panic with %v, got %v", ErrCurrencyMismatch, r)
+		}
+	}()
+	New(100, "USD").Add(New(50, "EUR"))
+}
+
+func TestValidate(t *testing.T) {
+	defer SetDecimal(2)
+	SetDecimal(3)
+
+	var fixtures = []struct {
+		m   *Money
+		err error
+	}{
+		{New(123456, "USD"), nil},
+		{New(-1234, "JPY"), nil},
+		{New(1234, "BHD"), nil},
+		{NewWithScale(12300, "USD", 4), nil},
+		{NewWithScale(12345, "USD", 4), ErrMoneyInvalidPrecision},
+		{NewWithScale(12, "USD", 1), nil},
+		{&Money{M: 1230, C: "USD"}, nil},
+		{&Money{M: 1234, C: "USD"}, ErrMoneyInvalidPrecision},
+		{&Money{M: 1000, C: "JPY"}, nil},
+		{&Money{M: 1500, C: "JPY"}, ErrMoneyInvalidPrecision},
+		{New(100, "XYZ"), ErrMoneyUnknownCurrency},
+		{New(100, ""), ErrMoneyUnknownCurrency},
+		{nil, ErrMoneyNil},
+	}
+
+	for i, f := range fixtures {
+		if err := f.m.Validate(); err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+	}
+}
+
+func TestZero(t *testing.T) {
+	m := Zero("EUR")
+	if !m.IsZero() || m.C != "EUR" {
+		t.Errorf("expected %v, got %v", "0.00 EUR", m)
+	}
+	if z := Zero("JPY"); !z.Equals(New(0, "JPY")) || z.String() != "0 JPY" {
+		t.Errorf("expected %v, got %v", "0 JPY", z)
+	}
+
+	total := Zero("EUR")
+	for _, n := range []*Money{New(123, "EUR"), New(877, "EUR")} {
+		total.Add(n)
+	}
+	if total.M != 1000 || total.C != "EUR" {
+		t.Errorf("expected %v, got %v", "10.00 EUR", total)
+	}
+}
+
+func TestNewWithScale(t *testing.T) {
+	usd := NewWithScale(123456, "USD", 2)
+	bhd := NewWithScale(123456, "BHD", 3)
+	jpy := NewWithScale(123456, "JPY", 0)
+
+	if got := usd.Get(); got != 1234.56 {
+		t.Errorf("expected money amount to be %v, got %v", 1234.56, got)
+	}
+	if got := bhd.Get(); got != 123.456 {
+		t.Errorf("expected money amount to be %v, got %v", 123.456, got)
+	}
+	if got := jpy.Get(); got != 123456 {
+		t.Errorf("expected money amount to be %v, got %v", 123456, got)
+	}
+	if got := bhd.Gett(); got != 123 {
+		t.Errorf("expected money amount to be %v, got %v", 123, got)
+	}
+
+	// The package-wide DP does not affect Money with its own scale
+	SetDecimal(4)
+	defer SetDecimal(2)
+	if got := usd.Get(); got != 1234.56 {
+		t.Errorf("expected money amount to be %v, got %v", 1234.56, got)
+	}
+	if got := NewWithScale(123456, "USD", 3).Format("en_US"); got != "$123.456" {
+		t.Errorf("expected %s, got %s", "$123.456", got)
+	}
+	if got := jpy.Format("ja_JP"); got != "¥123,456" {
+		t.Errorf("expected %s, got %s", "¥123,456", got)
+	}
+}
+
+func TestArithmeticWithScale(t *testing.T) {
+	m := NewWithScale(1500, "BHD", 3)
+
+	if got := m.Plus(NewWithScale(250, "BHD", 3)); got.M != 1750 || got.Get() != 1.75 {
+		t.Errorf("expected Plus to be %v, got %v", 1.75, got.Get())
+	}
+	// Amounts with different decimal places are aligned to the finer one
+	if got := NewWithScale(150, "BHD", 2).Plus(m); got.M != 3000 || got.Get() != 3 {
+		t.Errorf("expected Plus to be %v, got %v", 3, got.Get())
+	}
+	if got := m.Times(NewWithScale(2000, "BHD", 3)); got.M != 3000 {
+		t.Errorf("expected Times to be %v, got %v", 3000, got.M)
+	}
+	if got := m.Cmp(NewWithScale(150, "BHD", 2)); got != 0 {
+		t.Errorf("expected Cmp to be %v, got %v", 0, got)
+	}
+	if got := m.Cmp(NewWithScale(151, "BHD", 2)); got != -1 {
+		t.Errorf("expected Cmp to be %v, got %v", -1, got)
+	}
+	if got := m.withAmount(m.M).Div(NewWithScale(3000, "BHD", 3)); got.M != 500 {
+		t.Errorf("expected Div to be %v, got %v", 500, got.M)
+	}
+	if got := NewWithScale(0, "BHD", 3).Setf(1.2345); got.M != 1235 {
+		t.Errorf("expected Setf to be %v, got %v", 1235, got.M)
+	}
+}
+
+func TestGetDecimal(t *testing.T) {
+	defer SetDecimal(2)
+
+	if got := GetDecimal(); got != 2 {
+		t.Errorf("expected decimal place to be %v, got %v", 2, got)
+	}
+	SetDecimalByCurrency("BHD")
+	if got := GetDecimal(); got != 3 {
+		t.Errorf("expected decimal place to be %v, got %v", 3, got)
+	}
+	SetDecimalByCurrency("JPY")
+	if got := GetDecimal(); got != 0 {
+		t.Errorf("expected decimal place to be %v, got %v", 0, got)
+	}
+}
+
+// Run with go test -race to detect unsynchronized access to DP.
+func TestSetDecimalConcurrently(t *testing.T) {
+	defer SetDecimal(2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				SetDecimalByCurrency("BHD")
+				SetDecimalByCurrency("USD")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			// Money without a currency uses the package-wide DP
+			for j := 0; j < 1000; j++ {
+				(&Money{M: 123}).Mul(&Money{M: 200})
+				(&Money{M: 123}).Mulf(2)
+				(&Money{M: 123}).Div(&Money{M: 200})
+				(&Money{M: 123}).Setf(1.5)
+				_ = (&Money{M: 123}).String()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMoneyFormatAccounting(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		locale   string
+		expected string
+	}{
+		{New(123456, "USD"), "en_US", "$1,234.56"},
+		{New(-123456, "USD"), "en_US", "($1,234.56)"},
+		{New(0, "USD"), "en_US", "$0.00"},
+		{New(0, "USD").Neg(), "en_US", "$0.00"},
+		{New(123456, "GBP"), "en_GB", "£1,234.56"},
+		{New(-123456, "GBP"), "en_GB", "(£1,234.56)"},
+		{New(-123456, "EUR"), "de_DE", "(1.234,56 €)"},
+		{New(-123456, "EUR"), "de_AT", "(€ 1.234,56)"},
+		{New(-1234567890, "EUR"), "de_CH", "(€ 12'345'678.90)"},
+		{New(-123456, "EUR"), "xx_XX", "-1234.56 EUR"},
+	}
+
+	for _, f := range fixtures {
+		got := f.m.FormatAccounting(f.locale)
+		if got != f.expected {
+			t.Errorf("expected %s, got %s (locale: %s)", f.expected, got, f.locale)
+		}
+	}
+}
+
+func TestMoneyFormatSymbolWithN(t *testing.T) {
+	got := New(-123456, "HRK").Format("hr_HR")
+	expected := "-1.234,56 kn"
+	if got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestMoneyFormatNumber(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		locale   string
+		expected string
+	}{
+		{New(123456, "USD"), "en_US", "1,234.56"},
+		{New(-123456, "USD"), "en_US", "(1,234.56)"},
+		{New(0, "USD"), "en_US", "0.00"},
+		{New(-123456, "GBP"), "en_GB", "-1,234.56"},
+		{New(123456, "EUR"), "de_DE", "1.234,56"},
+		{New(-123456, "EUR"), "de_DE", "-1.234,56"},
+		{New(-123456, "EUR"), "de_AT", "-1.234,56"},
+		{New(-1234567890, "EUR"), "de_CH", "-12'345'678.90"},
+		{New(-123456, "INR"), "en_IN", "-1,234.56"},
+		{New(-123456, "AED"), "ar_AE", "1,234.56-"},
+		{New(1234567890, "JPY"), "ja_JP", "1,234,567,890"},
+		{New(-123456, "EUR"), "xx_XX", "-1234.56"},
+	}
+
+	for _, f := range fixtures {
+		got := f.m.FormatNumber(f.locale)
+		if got != f.expected {
+			t.Errorf("expected %s, got %s (locale: %s)", f.expected, got, f.locale)
+		}
+	}
+}
+
+func TestMoneyFormatWithCode(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		locale   string
+		expected string
+	}{
+		{New(123456, "USD"), "en_US", "USD 1,234.56"},
+		{New(-123456, "USD"), "en_US", "(USD 1,234.56)"},
+		{New(123456, "CAD"), "en_US", "CAD 1,234.56"},
+		{New(123456, "EUR"), "de_DE", "1.234,56 EUR"},
+		{New(-123456, "EUR"), "de_DE", "-1.234,56 EUR"},
+		{New(123456, "EUR"), "de_AT", "EUR 1.234,56"},
+		{New(-1234567890, "EUR"), "de_CH", "EUR-12'345'678.90"},
+		{New(123456, "ALL"), "sq_AL", "1.234,56 ALL"},
+		{New(-123456, "ALL"), "sq_AL", "-1.234,56 ALL"},
+		{New(-123456, "EUR"), "xx_XX", "-1234.56 EUR"},
+	}
+
+	for _, f := range fixtures {
+		got := f.m.FormatWithCode(f.locale)
+		if got != f.expected {
+			t.Errorf("expected %s, got %s (locale: %s)", f.expected, got, f.locale)
+		}
+	}
+}
+
+func TestMoneyFormatGroupSizes(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		locale   string
+		expected string
+	}{
+		{New(10000000, "INR"), "en_IN", "1,00,000.00"},
+		{New(100000000, "INR"), "en_IN", "10,00,000.00"},
+		{New(1000000000, "INR"), "en_IN", "1,00,00,000.00"},
+		{New(123456789, "INR"), "en_IN", "12,34,567.89"},
+		{New(-123456789, "INR"), "en_IN", "-12,34,567.89"},
+		{New(99900, "INR"), "en_IN", "999.00"},
+		{New(10000000, "USD"), "en_US", "100,000.00"},
+		{New(100000000, "USD"), "en_US", "1,000,000.00"},
+		{New(1000000000, "USD"), "en_US", "10,000,000.00"},
+	}
+
+	for _, f := range fixtures {
+		got := f.m.FormatNumber(f.locale)
+		if got != f.expected {
+			t.Errorf("expected %s, got %s (locale: %s)", f.expected, got, f.locale)
+		}
+	}
+}
+
+func TestZeroDecimalCurrency(t *testing.T) {
+	m := New(1000, "JPY")
+	if got := m.String(); got != "1000 JPY" {
+		t.Errorf("expected %s, got %s", "1000 JPY", got)
+	}
+	if got := New(-1000, "JPY").String(); got != "-1000 JPY" {
+		t.Errorf("expected %s, got %s", "-1000 JPY", got)
+	}
+	if got := m.Get(); got != 1000 {
+		t.Errorf("expected money amount to be %v, got %v", 1000, got)
+	}
+	if got := m.Gett(); got != 1000 {
+		t.Errorf("expected money amount to be %v, got %v", 1000, got)
+	}
+	if got := m.Format("ja_JP"); got != "¥1,000" {
+		t.Errorf("expected %s, got %s", "¥1,000", got)
+	}
+	if got := m.Format("de_DE"); got != "1.000 ¥" {
+		t.Errorf("expected %s, got %s", "1.000 ¥", got)
+	}
+
+	// The minor unit is the major unit
+	if got := New(0, "JPY").Setf(1000.4); got.M != 1000 {
+		t.Errorf("expected money amount to be %v, got %v", 1000, got.M)
+	}
+	if got := m.Times(New(3, "JPY")); got.M != 3000 {
+		t.Errorf("expected money amount to be %v, got %v", 3000, got.M)
+	}
+	if got := New(1000, "JPY").Div(New(3, "JPY")); got.M != 333 {
+		t.Errorf("expected money amount to be %v, got %v", 333, got.M)
+	}
+	if got, err := NewFromString("1000", "JPY"); err != nil || !got.Equals(m) {
+		t.Errorf("expected %v, got %v (error: %v)", m, got, err)
+	}
+	if _, err := NewFromString("1000.5", "JPY"); err != ErrMoneyDecimalPlacesTooLarge {
+		t.Errorf("expected error %v, got %v", ErrMoneyDecimalPlacesTooLarge, err)
+	}
+}
+
+func TestCurrencySetters(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		expected *Money
+		s        string
+	}{
+		{new(Money).Setc(1000, "JPY"), New(1000, "JPY"), "1000 JPY"},
+		{new(Money).Setc(1234, "BHD"), New(1234, "BHD"), "1.234 BHD"},
+		{new(Money).Setfc(1000, "JPY"), New(1000, "JPY"), "1000 JPY"},
+		{new(Money).Setfc(1.2345, "BHD"), New(1235, "BHD"), "1.235 BHD"},
+		{New(1234, "USD").SetCurrency("JPY"), New(1234, "JPY"), "1234 JPY"},
+		{New(1234, "USD").SetCurrency("BHD"), New(1234, "BHD"), "1.234 BHD"},
+		{NewWithScale(1234, "JPY", 2).SetCurrency("JPY"), New(1234, "JPY"), "1234 JPY"},
+		{new(Money).Set(1000).SetCurrencyByLocale("ja_JP"), New(1000, "JPY"), "1000 JPY"},
+		{new(Money).Set(1234).SetCurrencyByLocale("ar_BH"), New(1234, "BHD"), "1.234 BHD"},
+	}
+
+	for i, f := range fixtures {
+		if !f.m.Equals(f.expected) || f.m.C != f.expected.C {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, f.m)
+		}
+		if got := f.m.String(); got != f.s {
+			t.Errorf("%d. expected %s, got %s", i, f.s, got)
+		}
+	}
+
+	if got := new(Money).Setfc(1000, "JPY").Format("ja_JP"); got != "¥1,000" {
+		t.Errorf("expected %s, got %s", "¥1,000", got)
+	}
+	if got := New(1234, "USD").SetCurrency("JPY").Format("ja_JP"); got != "¥1,234" {
+		t.Errorf("expected %s, got %s", "¥1,234", got)
+	}
+}
+
+func TestScaleByCurrency(t *testing.T) {
+	defer SetDecimal(2)
+	defer SetScaleByCurrency(true)
+
+	SetScaleByCurrency(false)
+	SetDecimal(4)
+	var fixtures = []struct {
+		m        *Money
+		expected string
+	}{
+		{New(12345, "USD"), "1.2345 USD"},
+		{New(1000, "JPY"), "0.1000 JPY"},
+		{New(0, "USD").Setf(1.2345), "1.2345 USD"},
+		{new(Money).Setc(12345, "USD"), "1.2345 USD"},
+		{New(1234, "USD").SetCurrency("JPY"), "0.1234 JPY"},
+		// Explicitly in minor units of the currency
+		{NewFromMinorUnits(1000, "JPY"), "1000 JPY"},
+		{NewFromFloat(1.2345, "USD"), "1.23 USD"},
+	}
+
+	for i, f := range fixtures {
+		if got := f.m.String(); got != f.expected {
+			t.Errorf("%d. expected %s, got %s", i, f.expected, got)
+		}
+	}
+	if m, err := FromMinor(1234, "BHD"); err != nil || m.String() != "1.234 BHD" {
+		t.Errorf("expected %s, got %v (error: %v)", "1.234 BHD", m, err)
+	}
+}
+
+func TestStringDoesNotModify(t *testing.T) {
+	m := New(-123, "EUR")
+	if got := m.String(); got != "-1.23 EUR" {
+		t.Errorf("expected %s, got %s", "-1.23 EUR", got)
+	}
+	if m.M != -123 {
+		t.Errorf("expected money amount to be %v, got %v", -123, m.M)
+	}
+}
+
+func TestStringDecimalDigits(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		expected string
+	}{
+		{New(1234, "JPY"), "1234 JPY"},
+		{New(-1234, "JPY"), "-1234 JPY"},
+		{New(1234, "USD"), "12.34 USD"},
+		{New(-5, "USD"), "-0.05 USD"},
+		{New(1234, "BHD"), "1.234 BHD"},
+		{New(-5, "BHD"), "-0.005 BHD"},
+		{New(1234, "XYZ"), "12.34 XYZ"},
+	}
+
+	for i, f := range fixtures {
+		if got := f.m.String(); got != f.expected {
+			t.Errorf("%d. expected %s, got %s", i, f.expected, got)
+		}
+	}
+
+	// The decimal digits of the currency do not depend on DP
+	defer SetDecimal(2)
+	SetDecimal(4)
+	for i, f := range fixtures[:6] {
+		if got := f.m.String(); got != f.expected {
+			t.Errorf("%d. expected %s with DP 4, got %s", i, f.expected, got)
+		}
+	}
+}
+
+func TestStringSymbol(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		expected string
+	}{
+		{New(123456, "USD"), "$1234.56"},
+		{New(-123456, "USD"), "-$1234.56"},
+		{New(5, "USD"), "$0.05"},
+		{New(1234, "JPY"), "¥1234"},
+		{New(-1234, "JPY"), "-¥1234"},
+		{New(-5, "EUR"), "-€0.05"},
+		{NewWithScale(12345, "USD", 3), "$12.345"},
+		{New(123456, "XYZ"), "1234.56 XYZ"},
+	}
+
+	for i, f := range fixtures {
+		if got := f.m.StringSymbol(); got != f.expected {
+			t.Errorf("%d. expected %s, got %s", i, f.expected, got)
+		}
+	}
+}
+
+func TestThreeDecimalCurrency(t *testing.T) {
+	defer SetDecimal(2)
+	SetDecimalByCurrency("BHD")
+
+	var fixtures = []struct {
+		m        *Money
+		expected string
+	}{
+		{&Money{M: 1234, C: "BHD"}, "1.234 BHD"},
+		{&Money{M: -1234, C: "BHD"}, "-1.234 BHD"},
+		{&Money{M: 1005, C: "BHD"}, "1.005 BHD"},
+		{&Money{M: -5, C: "BHD"}, "-0.005 BHD"},
+		{&Money{M: 0, C: "BHD"}, "0.000 BHD"},
+		{New(1234, "BHD"), "1.234 BHD"},
+		{New(-1005, "KWD"), "-1.005 KWD"},
+		{New(50, "OMR"), "0.050 OMR"},
+	}
+
+	for _, f := range fixtures {
+		if got := f.m.String(); got != f.expected {
+			t.Errorf("expected %s, got %s", f.expected, got)
+		}
+	}
+
+	m := &Money{M: -1234, C: "BHD"}
+	if got := m.Get(); got != -1.234 {
+		t.Errorf("expected money amount to be %v, got %v", -1.234, got)
+	}
+	if got := m.Gett(); got != -1 {
+		t.Errorf("expected money amount to be %v, got %v", -1, got)
+	}
+	if got := m.FormatNumber("ar_BH"); got != "1.234-" {
+		t.Errorf("expected %s, got %s", "1.234-", got)
+	}
+	if got := New(1234, "BHD").FormatNumber("en_US"); got != "1.234" {
+		t.Errorf("expected %s, got %s", "1.234", got)
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		zero     bool
+		positive bool
+		negative bool
+		sign     int
+		signum   int
+	}{
+		{New(-1, "EUR"), false, false, true, -1, -1},
+		{New(0, "EUR"), true, false, false, 1, 0},
+		{New(1, "EUR"), false, true, false, 1, 1},
+	}
+
+	for i, f := range fixtures {
+		if got := f.m.IsZero(); got != f.zero {
+			t.Errorf("%d. expected IsZero to be %v, got %v", i, f.zero, got)
+		}
+		if got := f.m.IsPositive(); got != f.positive {
+			t.Errorf("%d. expected IsPositive to be %v, got %v", i, f.positive, got)
+		}
+		if got := f.m.IsNegative(); got != f.negative {
+			t.Errorf("%d. expected IsNegative to be %v, got %v", i, f.negative, got)
+		}
+		if got := f.m.Sign(); got != f.sign {
+			t.Errorf("%d. expected Sign to be %v, got %v", i, f.sign, got)
+		}
+		if got := f.m.Signum(); got != f.signum {
+			t.Errorf("%d. expected Signum to be %v, got %v", i, f.signum, got)
+		}
+	}
+}
+
+func TestAbsoluteNegated(t *testing.T) {
+	var fixtures = []struct {
+		m        int64
+		absolute int64
+		negated  int64
+	}{
+		{-123, 123, 123},
+		{123, 123, -123},
+		{0, 0, 0},
+		{math.MaxInt64, math.MaxInt64, -math.MaxInt64},
+	}
+
+	for i, f := range fixtures {
+		m := New(f.m, "EUR")
+		if got := m.Absolute(); got.M != f.absolute || got.C != "EUR" {
+			t.Errorf("%d. expected Absolute to be %v, got %v", i, f.absolute, got.M)
+		}
+		if got := m.Negated(); got.M != f.negated || got.C != "EUR" {
+			t.Errorf("%d. expected Negated to be %v, got %v", i, f.negated, got.M)
+		}
+		if m.M != f.m {
+			t.Errorf("%d. expected receiver to be unchanged, got %v", i, m.M)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r != ErrMoneyOverflow {
+			t.Errorf("expected panic with %v, got %v", ErrMoneyOverflow, r)
+		}
+	}()
+	New(math.MinInt64, "EUR").Absolute()
+}
+
+func TestCopy(t *testing.T) {
+	var fixtures = []*Money{
+		New(123, "EUR"),
+		New(-1000, "JPY"),
+		NewWithScale(1234, "BHD", 4),
+		&Money{M: 5, C: ""},
+	}
+
+	for i, m := range fixtures {
+		c := m.Copy()
+		if c == m {
+			t.Fatalf("%d. expected a distinct pointer", i)
+		}
+		if *c != *m {
+			t.Errorf("%d. expected copy to be %v, got %v", i, *m, *c)
+		}
+		orig := *m
+		c.Add(New(1, m.C)).SetCurrency("USD")
+		if *m != orig {
+			t.Errorf("%d. expected original to be unchanged, got %v", i, *m)
+		}
+	}
+}
+
+func TestMulLarge(t *testing.T) {
+	var fixtures = []struct {
+		m        int64
+		n        int64
+		expected int64
+	}{
+		{math.MaxInt64, 100, math.MaxInt64},
+		{math.MinInt64, 100, math.MinInt64},
+		{math.MaxInt64 / 2, 200, math.MaxInt64 - 1},
+		{math.MaxInt64 / 100, 150, math.MaxInt64 / 100 * 3 / 2},
+		{1000000000, 150, 1500000000},
+		{333, 50, 167},
+		{-333, 50, -167},
+	}
+
+	for i, f := range fixtures {
+		if got := New(f.m, "USD").Mul(New(f.n, "USD")); got.M != f.expected {
+			t.Errorf("%d. expected Mul to be %v, got %v", i, f.expected, got.M)
+		}
+		if got := New(f.m, "USD").Mulf(float64(f.n) / 100); got.M != f.expected {
+			t.Errorf("%d. expected Mulf to be %v, got %v", i, f.expected, got.M)
+		}
+	}
+}
+
+func TestMulOverflow(t *testing.T) {
+	var fixtures = []func(){
+		func() { New(math.MaxInt64, "USD").Mul(New(101, "USD")) },
+		func() { New(math.MaxInt64, "USD").Mulf(1.01) },
+	}
+
+	for i, f := range fixtures {
+		func() {
+			defer func() {
+				if r := recover(); r != ErrMoneyOverflow {
+					t.Errorf("%d. expected panic with %v, got %v", i, ErrMoneyOverflow, r)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestRatio(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		n        *Money
+		expected float64
+		err      error
+	}{
+		{New(10000, "USD"), New(10000, "USD"), 1, nil},
+		{New(5000, "USD"), New(10000, "USD"), 0.5, nil},
+		{New(10000, "USD"), New(5000, "USD"), 2, nil},
+		{New(-2500, "USD"), New(10000, "USD"), -0.25, nil},
+		{New(0, "USD"), New(10000, "USD"), 0, nil},
+		{New(100, "USD"), New(300, "USD"), 1.0 / 3, nil},
+		{NewWithScale(50000, "USD", 3), New(10000, "USD"), 0.5, nil},
+		{New(math.MaxInt64, "USD"), New(math.MaxInt64, "USD"), 1, nil},
+		{New(10000, "USD"), New(0, "USD"), 0, ErrMoneyDivideByZero},
+		{New(10000, "USD"), New(10000, "EUR"), 0, ErrCurrencyMismatch},
+	}
+
+	for i, f := range fixtures {
+		r, err := f.m.Ratio(f.n)
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+		if r != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, r)
+		}
+	}
+}
+
+func TestDivErr(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		n        *Money
+		expected int64
+		err      error
+	}{
+		{New(100, "USD"), New(300, "USD"), 33, nil},
+		{New(200, "USD"), New(300, "USD"), 67, nil},
+		{New(-200, "USD"), New(300, "USD"), -67, nil},
+		{New(100, "USD"), New(-300, "USD"), -33, nil},
+		{New(math.MaxInt64, "USD"), New(300, "USD"), math.MaxInt64 / 3, nil},
+		{New(math.MaxInt64/3*3, "USD"), New(700, "USD"), 1317624576693539401, nil},
+		{New(100, "USD"), New(0, "USD"), 100, ErrMoneyDivideByZero},
+		{New(math.MaxInt64, "USD"), New(50, "USD"), math.MaxInt64, ErrMoneyOverflow},
+	}
+
+	for i, f := range fixtures {
+		_, err := f.m.DivErr(f.n)
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+		if f.m.M != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, f.m.M)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r != ErrMoneyDivideByZero {
+			t.Errorf("expected panic with %v, got %v", ErrMoneyDivideByZero, r)
+		}
+	}()
+	New(100, "USD").Div(New(0, "USD"))
+}
+
+func TestMulInt(t *testing.T) {
+	var fixtures = []struct {
+		m        int64
+		q        int64
+		expected int64
+	}{
+		{1999, 3, 5997},
+		{1999, -3, -5997},
+		{-1999, -3, 5997},
+		{1999, 0, 0},
+		{math.MaxInt64, 1, math.MaxInt64},
+	}
+
+	for i, f := range fixtures {
+		m := New(f.m, "USD")
+		if got := m.MulInt(f.q); got.M != f.expected || got.C != "USD" {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, got.M)
+		}
+		if m.M != f.m {
+			t.Errorf("%d. expected receiver to be unchanged, got %v", i, m.M)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r != ErrMoneyOverflow {
+			t.Errorf("expected panic with %v, got %v", ErrMoneyOverflow, r)
+		}
+	}()
+	New(math.MaxInt64, "USD").MulInt(2)
+}
+
+func TestDivInt(t *testing.T) {
+	var fixtures = []struct {
+		m         int64
+		q         int64
+		quotient  int64
+		remainder int64
+		err       error
+	}{
+		{1000, 3, 333, 1, nil},
+		{1000, -3, -333, 1, nil},
+		{-1000, 3, -333, -1, nil},
+		{-1000, -3, 333, -1, nil},
+		{999, 3, 333, 0, nil},
+		{2, 3, 0, 2, nil},
+		{1000, 0, 0, 0, ErrMoneyDivideByZero},
+		{math.MinInt64, -1, 0, 0, ErrMoneyOverflow},
+	}
+
+	for i, f := range fixtures {
+		m := New(f.m, "USD")
+		q, r, err := m.DivInt(f.q)
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+		if err != nil {
+			continue
+		}
+		if q.M != f.quotient || q.C != "USD" {
+			t.Errorf("%d. expected quotient %v, got %v", i, f.quotient, q.M)
+		}
+		if r.M != f.remainder || r.C != "USD" {
+			t.Errorf("%d. expected remainder %v, got %v", i, f.remainder, r.M)
+		}
+		if q.M*f.q+r.M != f.m {
+			t.Errorf("%d. expected quotient * q + remainder to be %v, got %v", i, f.m, q.M*f.q+r.M)
+		}
+		if m.M != f.m {
+			t.Errorf("%d. expected receiver to be unchanged, got %v", i, m.M)
+		}
+	}
+}
+
+func TestDivMod(t *testing.T) {
+	var fixtures = []struct {
+		m         int64
+		n         int64
+		quotient  int64
+		remainder int64
+		err       error
+	}{
+		{100, 3, 33, 1, nil},
+		{100, -3, -34, -2, nil},
+		{-100, 3, -34, 2, nil},
+		{-100, -3, 33, -1, nil},
+		{99, 3, 33, 0, nil},
+		{-99, 3, -33, 0, nil},
+		{2, 3, 0, 2, nil},
+		{-2, 3, -1, 1, nil},
+		{math.MinInt64, 2, math.MinInt64 / 2, 0, nil},
+		{math.MinInt64, 3, -3074457345618258603, 1, nil},
+		{math.MaxInt64, -2, -4611686018427387904, -1, nil},
+		{100, 0, 0, 0, ErrMoneyDivideByZero},
+		{math.MinInt64, -1, 0, 0, ErrMoneyOverflow},
+	}
+
+	for i, f := range fixtures {
+		m := New(f.m, "USD")
+		q, r, err := m.DivMod(f.n)
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+		if err != nil {
+			continue
+		}
+		if q.M != f.quotient || q.C != "USD" {
+			t.Errorf("%d. expected quotient %v, got %v", i, f.quotient, q.M)
+		}
+		if r.M != f.remainder || r.C != "USD" {
+			t.Errorf("%d. expected remainder %v, got %v", i, f.remainder, r.M)
+		}
+		if q.M*f.n+r.M != f.m {
+			t.Errorf("%d. expected quotient * n + remainder to be %v, got %v", i, f.m, q.M*f.n+r.M)
+		}
+		if m.M != f.m {
+			t.Errorf("%d. expected receiver to be unchanged, got %v", i, m.M)
+		}
+	}
+}
+
+func TestNewFromUnits(t *testing.T) {
+	var fixtures = []struct {
+		units    int64
+		currency string
+		minor    string
+		major    string
+	}{
+		{5, "JPY", "5 JPY", "5 JPY"},
+		{5, "USD", "0.05 USD", "5.00 USD"},
+		{5, "BHD", "0.005 BHD", "5.000 BHD"},
+		{-5, "USD", "-0.05 USD", "-5.00 USD"},
+		{0, "BHD", "0.000 BHD", "0.000 BHD"},
+	}
+
+	for i, f := range fixtures {
+		if got := NewFromMinorUnits(f.units, f.currency).String(); got != f.minor {
+			t.Errorf("%d. expected NewFromMinorUnits to be %v, got %v", i, f.minor, got)
+		}
+		if got := NewFromMajorUnits(f.units, f.currency).String(); got != f.major {
+			t.Errorf("%d. expected NewFromMajorUnits to be %v, got %v", i, f.major, got)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r != ErrMoneyOverflow {
+			t.Errorf("expected panic with %v, got %v", ErrMoneyOverflow, r)
+		}
+	}()
+	NewFromMajorUnits(math.MaxInt64/10, "USD")
+}
+
+func TestNewFromFloat(t *testing.T) {
+	var fixtures = []struct {
+		f        float64
+		currency string
+		expected string
+	}{
+		{1.005, "USD", "1.01 USD"},
+		{1.004, "USD", "1.00 USD"},
+		{-1.005, "USD", "-1.01 USD"},
+		{0.1, "USD", "0.10 USD"},
+		{1000.7, "JPY", "1001 JPY"},
+		{1000.2, "JPY", "1000 JPY"},
+		{1.2345, "BHD", "1.235 BHD"},
+		{1e6, "USD", "1000000.00 USD"},
+		{0, "EUR", "0.00 EUR"},
+	}
+
+	for i, f := range fixtures {
+		if got := NewFromFloat(f.f, f.currency).String(); got != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, got)
+		}
+	}
+
+	SetRoundingMode(Floor)
+	defer SetRoundingMode(HalfUp)
+	if got := NewFromFloat(1.009, "USD").String(); got != "1.00 USD" {
+		t.Errorf("expected %v, got %v", "1.00 USD", got)
+	}
+}
+
+func TestNewFromFloatOverflow(t *testing.T) {
+	var fixtures = []struct {
+		f   float64
+		err error
+	}{
+		{math.NaN(), ErrMoneyNotFinite},
+		{math.Inf(1), ErrMoneyNotFinite},
+		{math.Inf(-1), ErrMoneyNotFinite},
+		{1e20, ErrMoneyOverflow},
+	}
+
+	for i, f := range fixtures {
+		func() {
+			defer func() {
+				if r := recover(); r != f.err {
+					t.Errorf("%d. expected panic with %v, got %v", i, f.err, r)
+				}
+			}()
+			NewFromFloat(f.f, "USD")
+		}()
+	}
+}
+
+func TestAsUnits(t *testing.T) {
+	var fixtures = []struct {
+		m          *Money
+		minor      int64
+		major      int64
+		majorFloat float64
+	}{
+		{New(1299, "JPY"), 1299, 1299, 1299},
+		{New(1299, "USD"), 1299, 12, 12.99},
+		{New(-1299, "USD"), -1299, -12, -12.99},
+		{New(1299, "BHD"), 1299, 1, 1.299},
+		{New(99, "EUR"), 99, 0, 0.99},
+		{NewWithScale(12345, "USD", 3), 12345, 12, 12.345},
+	}
+
+	for i, f := range fixtures {
+		if got := f.m.AsMinorUnits(); got != f.minor {
+			t.Errorf("%d. expected AsMinorUnits to be %v, got %v", i, f.minor, got)
+		}
+		if got := f.m.AsMajorUnits(); got != f.major {
+			t.Errorf("%d. expected AsMajorUnits to be %v, got %v", i, f.major, got)
+		}
+		if got := f.m.AsMajorUnitsFloat(); got != f.majorFloat {
+			t.Errorf("%d. expected AsMajorUnitsFloat to be %v, got %v", i, f.majorFloat, got)
+		}
+	}
+}
+
+func TestGoString(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		expected string
+	}{
+		{New(123456, "USD"), `money.New(123456, "USD") /* 1234.56 USD */`},
+		{New(-1234, "JPY"), `money.New(-1234, "JPY") /* -1234 JPY */`},
+		{NewWithScale(1234567, "USD", 3), `money.NewWithScale(1234567, "USD", 3) /* 1234.567 USD */`},
+		{NewWithScale(1234, "XYZ", 2), `money.NewWithScale(1234, "XYZ", 2) /* 12.34 XYZ */`},
+		{&Money{M: 123456, C: "USD"}, `&money.Money{M: 123456, C: "USD"} /* 1234.56 USD */`},
+	}
+
+	for i, f := range fixtures {
+		if got := fmt.Sprintf("%#v", f.m); got != f.expected {
+			t.Errorf("%d. expected %s, got %s", i, f.expected, got)
+		}
+	}
+}
+
+func TestSetfErrMulfErr(t *testing.T) {
+	var fixtures = []struct {
+		f   float64
+		err error
+	}{
+		{math.NaN(), ErrMoneyNotFinite},
+		{math.Inf(1), ErrMoneyNotFinite},
+		{math.Inf(-1), ErrMoneyNotFinite},
+		{1e17, ErrMoneyOverflow},
+		{-1e17, ErrMoneyOverflow},
+		{1.5, nil},
+	}
+
+	for i, f := range fixtures {
+		m := New(200, "USD")
+		if _, err := m.SetfErr(f.f); err != f.err {
+			t.Errorf("%d. expected SetfErr error %v, got %v", i, f.err, err)
+		}
+		if f.err != nil && m.M != 200 {
+			t.Errorf("%d. expected SetfErr to leave m unchanged, got %v", i, m.M)
+		}
+
+		m = New(200, "USD")
+		if _, err := m.MulfErr(f.f); err != f.err {
+			t.Errorf("%d. expected MulfErr error %v, got %v", i, f.err, err)
+		}
+		if f.err != nil && m.M != 200 {
+			t.Errorf("%d. expected MulfErr to leave m unchanged, got %v", i, m.M)
+		}
+	}
+
+	if m, _ := New(0, "USD").SetfErr(1.5); m.M != 150 {
+		t.Errorf("expected SetfErr to be %v, got %v", 150, m.M)
+	}
+	if m, _ := New(200, "USD").MulfErr(1.5); m.M != 300 {
+		t.Errorf("expected MulfErr to be %v, got %v", 300, m.M)
+	}
+}
+
+func TestSetfMode(t *testing.T) {
+	var fixtures = []struct {
+		f        float64
+		mode     RoundingMode
+		expected int64
+	}{
+		{0.125, HalfUp, 13},
+		{0.125, HalfEven, 12},
+		{2.665, HalfUp, 267},
+		{2.665, HalfEven, 266},
+		{2.675, HalfUp, 268},
+		{2.675, HalfEven, 268},
+		{2.675, HalfDown, 267},
+		{-0.125, HalfUp, -13},
+		{-0.125, HalfEven, -12},
+		{1.001, Ceil, 101},
+		{1.009, Floor, 100},
+		{1.5, HalfEven, 150},
+	}
+
+	for i, f := range fixtures {
+		if m := New(0, "USD").SetfMode(f.f, f.mode); m.M != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, m.M)
+		}
+	}
+
+	// Setf uses the package-wide rounding mode
+	defer SetRoundingMode(HalfUp)
+	for i, f := range fixtures {
+		SetRoundingMode(f.mode)
+		if m := New(0, "USD").Setf(f.f); m.M != f.expected {
+			t.Errorf("%d. expected Setf to be %v, got %v", i, f.expected, m.M)
+		}
+	}
+}
+
+func TestSetfMulfPanic(t *testing.T) {
+	var fixtures = []func(){
+		func() { New(100, "USD").Setf(math.NaN()) },
+		func() { New(100, "USD").Setfc(math.Inf(1), "EUR") },
+		func() { New(100, "USD").Mulf(math.NaN()) },
+		func() { New(100, "USD").Percent(math.Inf(-1)) },
+	}
+
+	for i, f := range fixtures {
+		func() {
+			defer func() {
+				if r := recover(); r != ErrMoneyNotFinite {
+					t.Errorf("%d. expected panic with %v, got %v", i, ErrMoneyNotFinite, r)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestMulErr(t *testing.T) {
+	var fixtures = []struct {
+		m        int64
+		n        int64
+		expected int64
+		err      error
+	}{
+		{123, 200, 246, nil},
+		{math.MaxInt64 / 2, 200, math.MaxInt64 - 1, nil},
+		// 92233720368547758.07 * 1000000.00 used to wrap to a negative amount
+		{math.MaxInt64, 100000000, math.MaxInt64, ErrMoneyOverflow},
+		{math.MinInt64, 200, math.MinInt64, ErrMoneyOverflow},
+		{4294967296, 429496729600, 4294967296, ErrMoneyOverflow},
+	}
+
+	for i, f := range fixtures {
+		m := New(f.m, "USD")
+		if _, err := m.MulErr(New(f.n, "USD")); err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+		if m.M != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, m.M)
+		}
+	}
+
+	m := New(math.MaxInt64, "USD")
+	if _, err := m.MulfErr(1e6); err != ErrMoneyOverflow {
+		t.Errorf("expected MulfErr error %v, got %v", ErrMoneyOverflow, err)
+	}
+	if m.M != math.MaxInt64 {
+		t.Errorf("expected MulfErr to leave m unchanged, got %v", m.M)
+	}
+}
+
+func TestNewValidated(t *testing.T) {
+	var fixtures = []struct {
+		code     string
+		expected string
+		err      error
+	}{
+		{"USD", "12.34 USD", nil},
+		{"usd", "12.34 USD", nil},
+		{" Eur ", "12.34 EUR", nil},
+		{"JPY", "1234 JPY", nil},
+		{"USS", "", ErrMoneyUnknownCurrency},
+		{"", "", ErrMoneyUnknownCurrency},
+	}
+
+	for i, f := range fixtures {
+		m, err := NewValidated(1234, f.code)
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+		if err == nil && m.String() != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, m)
+		}
+	}
+}
+
+func TestFromMinor(t *testing.T) {
+	// The package-wide DP must not matter
+	SetDecimal(4)
+	defer SetDecimal(2)
+
+	var fixtures = []struct {
+		minor    int64
+		code     string
+		locale   string
+		expected string
+		err      error
+	}{
+		{1234, "USD", "en_US", "$12.34", nil},
+		{-1234, "USD", "en_US", "($12.34)", nil},
+		{1234, "JPY", "ja_JP", "¥1,234", nil},
+		{1234, "BHD", "ar_BH", "د.ب.\u200f 1.234", nil},
+		{1234, "bhd", "ar_BH", "د.ب.\u200f 1.234", nil},
+		{1234, "XYZ", "en_US", "", ErrMoneyUnknownCurrency},
+	}
+
+	for i, f := range fixtures {
+		m, err := FromMinor(f.minor, f.code)
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+		if err == nil && m.Format(f.locale) != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, m.Format(f.locale))
+		}
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		expected string
+	}{
+		{New(123456, "USD"), "1234.56"},
+		{New(-123456, "USD"), "-1234.56"},
+		{New(5, "USD"), "0.05"},
+		{New(-5, "USD"), "-0.05"},
+		{New(0, "USD"), "0.00"},
+		{New(1234, "JPY"), "1234"},
+		{New(-1234, "JPY"), "-1234"},
+		{New(1234567, "BHD"), "1234.567"},
+		{New(-1, "BHD"), "-0.001"},
+		{NewWithScale(1234567, "USD", 3), "1234.567"},
+		{NewWithScale(1234560, "USD", 3), "1234.56"},
+		{NewWithScale(12345, "BHD", 2), "123.450"},
+		{New(math.MaxInt64, "USD"), "92233720368547758.07"},
+		{New(math.MinInt64, "USD"), "-92233720368547758.08"},
+	}
+
+	for i, f := range fixtures {
+		if s := f.m.Decimal(); s != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, s)
+		}
+	}
+}
+
+func TestKey(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		expected string
+	}{
+		{New(123456, "USD"), "USD:123456"},
+		{New(-123456, "USD"), "USD:-123456"},
+		{New(0, "JPY"), "JPY:0"},
+		{NewWithScale(123456, "USD", 2), "USD:123456"},
+		{NewWithScale(123456, "USD", 3), "USD:123456:3"},
+		{NewWithScale(1234560, "USD", 3), "USD:123456"},
+		{NewWithScale(12345, "USD", 1), "USD:123450"},
+		{NewWithScale(1234567, "JPY", 2), "JPY:1234567:2"},
+		{&Money{M: 123456, C: "USD"}, "USD:123456"},
+		{&Money{M: 123456}, ":123456"},
+		{NewWithScale(1234560, "", 3), ":123456"},
+		{NewCrypto(150000000, "BTC", 8), "BTC:150"},
+		{NewWithScale(math.MaxInt64, "USD", 1), "USD:9223372036854775807:1"},
+	}
+
+	for i, f := range fixtures {
+		if k := f.m.Key(); k != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, k)
+		}
+	}
+
+	// Equal Money has the same key, whatever its scale
+	var equal = [][]*Money{
+		{New(100, "USD"), New(100, "USD")},
+		{New(150, "USD"), NewWithScale(1500, "USD", 3)},
+		{New(150, "USD"), NewWithScale(15, "USD", 1)},
+		{New(150, "USD"), NewWithScale(150000, "USD", 5)},
+		{New(15, "JPY"), NewWithScale(1500, "JPY", 2)},
+		{NewWithScale(12345, "USD", 3), NewWithScale(1234500, "USD", 5)},
+	}
+	for i, pair := range equal {
+		if !pair[0].Equals(pair[1]) {
+			t.Fatalf("%d. expected %v to equal %v", i, pair[0], pair[1])
+		}
+		if pair[0].Key() != pair[1].Key() {
+			t.Errorf("%d. expected %v and %v to have the same key, got %v and %v", i, pair[0], pair[1], pair[0].Key(), pair[1].Key())
+		}
+	}
+
+	var distinct = []*Money{
+		New(100, "USD"),
+		New(101, "USD"),
+		New(-100, "USD"),
+		New(100, "EUR"),
+		NewWithScale(100, "USD", 3),
+		NewWithScale(100, "USD", 4),
+	}
+	keys := map[string]int{}
+	for i, m := range distinct {
+		if j, ok := keys[m.Key()]; ok {
+			t.Errorf("%d. expected a distinct key, got %v like %d", i, m.Key(), j)
+		}
+		keys[m.Key()] = i
+	}
+}
+
+func TestPow10(t *testing.T) {
+	for d := 0; d <= MAXDEC; d++ {
+		if pow10[d] != int64(math.Pow10(d)) {
+			t.Errorf("%d. expected %v, got %v", d, int64(math.Pow10(d)), pow10[d])
+		}
+		if newDecimal(d) != int(pow10[d]) {
+			t.Errorf("%d. expected newDecimal to be %v, got %v", d, pow10[d], newDecimal(d))
+		}
+	}
+}
+
+func TestDistance(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		n        *Money
+		expected int64
+		err      error
+	}{
+		{New(800, "USD"), New(500, "USD"), 300, nil},
+		{New(500, "USD"), New(800, "USD"), 300, nil},
+		{New(500, "USD"), New(500, "USD"), 0, nil},
+		{New(-500, "USD"), New(800, "USD"), 1300, nil},
+		{New(500, "USD"), New(-800, "USD"), 1300, nil},
+		{New(500, "USD"), NewWithScale(5001, "USD", 3), 1, nil},
+		{New(math.MaxInt64, "USD"), New(0, "USD"), math.MaxInt64, nil},
+		{New(math.MinInt64+1, "USD"), New(0, "USD"), math.MaxInt64, nil},
+		{New(0, "USD"), New(math.MinInt64, "USD"), 0, ErrMoneyOverflow},
+		{New(math.MinInt64, "USD"), New(0, "USD"), 0, ErrMoneyOverflow},
+		{New(math.MaxInt64, "USD"), New(-1, "USD"), 0, ErrMoneyOverflow},
+		{New(500, "USD"), New(800, "EUR"), 0, ErrCurrencyMismatch},
+	}
+
+	for i, f := range fixtures {
+		m, n := *f.m, *f.n
+		got, err := f.m.Distance(f.n)
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+		if err == nil && (got.M != f.expected || got.C != "USD") {
+			t.Errorf("%d. expected %v USD, got %v", i, f.expected, got)
+		}
+		if *f.m != m || *f.n != n {
+			t.Errorf("%d. expected m and n to be unchanged, got %v and %v", i, f.m, f.n)
+		}
+	}
+}
+
+func TestRegisteredCurrency(t *testing.T) {
+	for _, c := range []*currency.Currency{
+		{Code: "QPT", Symbol: "pts", NarrowSymbol: "pts", FullSymbol: "pts", DecimalDigits: 0},
+		{Code: "QBT", Symbol: "₿", NarrowSymbol: "₿", FullSymbol: "₿", DecimalDigits: 8},
+	} {
+		if err := currency.Register(c); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	var fixtures = []struct {
+		m        *Money
+		expected string
+		str      string
+	}{
+		{New(1500, "QPT"), "pts1,500", "1500 QPT"},
+		{New(-1500, "QPT"), "(pts1,500)", "-1500 QPT"},
+		{New(123456789, "QBT"), "₿1.23456789", "1.23456789 QBT"},
+		{New(1, "QBT"), "₿0.00000001", "0.00000001 QBT"},
+	}
+
+	for i, f := range fixtures {
+		if got := f.m.Format("en_US"); got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+		if got := f.m.String(); got != f.str {
+			t.Errorf("%d. expected %q, got %q", i, f.str, got)
+		}
+	}
+}
+
+func TestNewCrypto(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		str      string
+		expected string
+	}{
+		{NewCrypto(150000000, "BTC", 8), "1.50000000 BTC", "BTC 1.50000000"},
+		{NewCrypto(1, "BTC", 8), "0.00000001 BTC", "BTC 0.00000001"},
+		{NewCrypto(-123456789012345, "BTC", 8), "-1234567.89012345 BTC", "(BTC 1234567.89012345)"},
+		{NewCrypto(math.MaxInt64, "BTC", 8), "92233720368.54775807 BTC", "BTC 92233720368.54775807"},
+		{NewCrypto(1500000000000000000, "ETH", 18), "1.500000000000000000 ETH", "ETH 1.500000000000000000"},
+		{NewCrypto(1, "ETH", 18), "0.000000000000000001 ETH", "ETH 0.000000000000000001"},
+		{NewCrypto(math.MaxInt64, "ETH", 18), "9.223372036854775807 ETH", "ETH 9.223372036854775807"},
+		{NewCrypto(math.MinInt64, "ETH", 18), "-9.223372036854775808 ETH", "(ETH 9.223372036854775808)"},
+	}
+
+	// The package-wide DP must not matter
+	SetDecimal(4)
+	defer SetDecimal(2)
+
+	for i, f := range fixtures {
+		if got := f.m.String(); got != f.str {
+			t.Errorf("%d. expected %q, got %q", i, f.str, got)
+		}
+		if got := f.m.Format("en_US"); got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+	}
+
+	// Crypto amounts add up at their own scale
+	m := NewCrypto(150000000, "BTC", 8).Plus(NewCrypto(1, "BTC", 8))
+	if m.String() != "1.50000001 BTC" {
+		t.Errorf("expected %v, got %v", "1.50000001 BTC", m)
+	}
+
+	// More than about 9.22 ether in wei does not fit into an int64 and
+	// would need big.Int backing
+	if _, err := NewCrypto(math.MaxInt64, "ETH", 18).AddErr(NewCrypto(1, "ETH", 18)); err != ErrMoneyOverflow {
+		t.Errorf("expected error %v, got %v", ErrMoneyOverflow, err)
+	}
+
+	for _, d := range []int{-1, MAXDEC + 1} {
+		func() {
+			defer func() {
+				if r := recover(); r != ErrMoneyDecimalPlacesTooLarge {
+					t.Errorf("%d. expected panic with %v, got %v", d, ErrMoneyDecimalPlacesTooLarge, r)
+				}
+			}()
+			NewCrypto(1, "BTC", d)
+		}()
+	}
+}
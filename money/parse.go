@@ -0,0 +1,284 @@
+package money
+
+import (
+	"fmt"
+	"github.com/hailocab/i18n-go/currency"
+	"github.com/hailocab/i18n-go/locale"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Parses a string formatted for the given locale like Format, e.g.
+// "$1,234.56" for en_US or "-1 234,56 €" for fr_FR, into a new Money of
+// the currency cur, or of the locale if cur is empty.
+func ParseLocale(s, loc, cur string) (*Money, error) {
+	l := locale.Get(loc)
+	if l == nil {
+		return nil, fmt.Errorf("i18n: money cannot parse %q: unknown locale %s", s, loc)
+	}
+	if cur == "" {
+		cur = l.CurrencyCode
+	}
+
+	str, neg, ok := matchLayout(strings.TrimFunc(s, unicode.IsSpace), l, cur)
+	if !ok {
+		str, neg = stripAffixes(s, l, cur)
+	}
+
+	// Bring the number into the "1234.56" form
+	parts := strings.Split(str, l.CurrencyDecimalSeparator)
+	if len(parts) > 2 {
+		return nil, fmt.Errorf("i18n: money cannot parse %q: too many decimal separators", s)
+	}
+	whole, ok := ungroup(parts[0], l.CurrencyGroupSeparator, l.CurrencyGroupSizes)
+	if !ok {
+		return nil, fmt.Errorf("i18n: money cannot parse %q: misplaced group separator", s)
+	}
+	parts[0] = whole
+	number := strings.Join(parts, ".")
+	if neg {
+		number = "-" + number
+	}
+
+	m := New(0, cur)
+	if m.dp == 0 {
+		m = NewWithScale(0, cur, l.CurrencyDecimalDigits)
+	}
+	x, err := parseDecimal(number, decimalPlaces(m.scale()))
+	if err != nil {
+		return nil, fmt.Errorf("i18n: money cannot parse %q: %v", s, err)
+	}
+	return m.Set(x), nil
+}
+
+// Returns the whole number s without the group separator sep, or false if
+// the separators are not where the group sizes put them (see
+// locale.GroupDigits), e.g. "1,2,3" with a size of 3. Numbers without any
+// separator are accepted. Any kind of space is taken as sep if sep is a
+// (non-breaking) space.
+func ungroup(s, sep string, sizes []int) (string, bool) {
+	var groups []string
+	if strings.TrimFunc(sep, unicode.IsSpace) == "" {
+		groups = strings.FieldsFunc(s, unicode.IsSpace)
+	} else {
+		groups = strings.Split(s, sep)
+	}
+	digits := strings.Join(groups, "")
+	if len(groups) > 1 && locale.GroupDigits(digits, sizes, "|") != strings.Join(groups, "|") {
+		return "", false
+	}
+	return digits, true
+}
+
+// Matches s against the positive, negative and accounting patterns of
+// the locale l with the symbols and the code of the currency cur, see
+// patternOf. Returns the number in s and whether it is negative, or
+// false if s matches none of the patterns.
+func matchLayout(s string, l *locale.Locale, cur string) (number string, neg, ok bool) {
+	var symbols []string
+	if c := currency.Get(cur); c != nil {
+		symbols = append(symbols, c.Symbol, c.NarrowSymbol, c.FullSymbol)
+	}
+	if cur == l.CurrencyCode {
+		symbols = append(symbols, l.CurrencySymbol)
+	}
+	signs := []string{l.CurrencyNegativeSign, l.NegativeSign, "-"}
+
+	positive, negative := l.CurrencyPositivePattern, l.CurrencyNegativePattern
+	accounting := "(" + positive + ")"
+	for kind, candidates := range [][]string{symbols, {cur}, {""}} {
+		for _, symbol := range candidates {
+			if symbol == "" && kind != withoutSymbol {
+				continue
+			}
+			if number, ok := matchPattern(s, patternOf(positive, kind), symbol, ""); ok {
+				return number, false, true
+			}
+			if number, ok := matchPattern(s, patternOf(accounting, kind), symbol, ""); ok {
+				return number, true, true
+			}
+			for _, sign := range signs {
+				if sign == "" {
+					continue
+				}
+				if number, ok := matchPattern(s, patternOf(negative, kind), symbol, sign); ok {
+					return number, true, true
+				}
+			}
+		}
+	}
+	return "", false, false
+}
+
+// Matches s against the currency pattern, where "$" stands for symbol,
+// "-" for sign and "n" for the number, and spaces match any (possibly
+// empty) run of white space. Returns the number, which must start and
+// end with a digit, or false if s does not match.
+func matchPattern(s, pattern, symbol, sign string) (string, bool) {
+	i := strings.IndexRune(pattern, 'n')
+	if i < 0 {
+		return "", false
+	}
+	// Match the part in front of the number from the left
+	for _, r := range pattern[:i] {
+		if unicode.IsSpace(r) {
+			s = strings.TrimLeftFunc(s, unicode.IsSpace)
+			continue
+		}
+		token := patternToken(r, symbol, sign)
+		if token == "" || !strings.HasPrefix(s, token) {
+			return "", false
+		}
+		s = s[len(token):]
+	}
+	// and the part behind it from the right
+	suffix := []rune(pattern[i+1:])
+	for j := len(suffix) - 1; j >= 0; j-- {
+		if unicode.IsSpace(suffix[j]) {
+			s = strings.TrimRightFunc(s, unicode.IsSpace)
+			continue
+		}
+		token := patternToken(suffix[j], symbol, sign)
+		if token == "" || !strings.HasSuffix(s, token) {
+			return "", false
+		}
+		s = s[:len(s)-len(token)]
+	}
+	s = strings.TrimFunc(s, unicode.IsSpace)
+	if s == "" || !isDigit(s[0]) || !isDigit(s[len(s)-1]) {
+		return "", false
+	}
+	return s, true
+}
+
+// Returns the text that the rune r of a currency pattern stands for.
+func patternToken(r rune, symbol, sign string) string {
+	switch r {
+	case '$':
+		return symbol
+	case '-':
+		return sign
+	}
+	return string(r)
+}
+
+// Returns true if b is an ASCII digit.
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// Removes the currency symbol and code from s wherever they are and
+// returns the rest and whether it has a negative sign at either end or is
+// wrapped in parentheses. This is how ParseLocale parses strings that do
+// not match any pattern of the locale.
+func stripAffixes(s string, l *locale.Locale, cur string) (string, bool) {
+	str := s
+	if c := currency.Get(cur); c != nil && c.Symbol != "" {
+		str = strings.Replace(str, c.Symbol, "", -1)
+	}
+	if cur == l.CurrencyCode && l.CurrencySymbol != "" {
+		str = strings.Replace(str, l.CurrencySymbol, "", -1)
+	}
+	str = strings.Replace(str, cur, "", -1)
+	str = strings.TrimFunc(str, unicode.IsSpace)
+
+	// Negative values either have a sign in front of or behind the
+	// number, or are wrapped in parentheses (see FormatAccounting)
+	str, neg := trimParentheses(str)
+	for _, sign := range []string{l.NegativeSign, l.CurrencyNegativeSign} {
+		if neg || sign == "" {
+			break
+		}
+		if strings.HasPrefix(str, sign) {
+			neg = true
+			str = str[len(sign):]
+		} else if strings.HasSuffix(str, sign) {
+			neg = true
+			str = str[:len(str)-len(sign)]
+		}
+	}
+	return strings.TrimFunc(str, unicode.IsSpace), neg
+}
+
+// Returns s without the parentheses that wrap negative amounts in
+// accounting style, e.g. "1,234.56" for "(1,234.56)", and whether s was
+// wrapped in them. Spaces inside the parentheses are removed.
+func trimParentheses(s string) (string, bool) {
+	if !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ")") {
+		return s, false
+	}
+	return strings.TrimFunc(s[1:len(s)-1], unicode.IsSpace), true
+}
+
+// Parses a decimal number with an optional sign, digits and an optional
+// decimal point into minor units with the given number of decimal digits,
+// e.g. "-12.3" with 2 digits yields -1230.
+func parseDecimal(s string, digits int) (int64, error) {
+	neg := false
+	if strings.HasPrefix(s, "-") {
+		neg = true
+		s = s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	whole, frac := s, ""
+	if i := strings.Index(s, "."); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+		if frac == "" {
+			return 0, fmt.Errorf("missing digits after decimal point")
+		}
+	}
+	if whole == "" {
+		return 0, fmt.Errorf("missing digits")
+	}
+	for len(frac) > digits && strings.HasSuffix(frac, "0") {
+		frac = frac[:len(frac)-1]
+	}
+	if len(frac) > digits {
+		return 0, ErrMoneyDecimalPlacesTooLarge
+	}
+	frac += strings.Repeat("0", digits-len(frac))
+
+	// Accumulate into an unsigned value to be able to represent MinInt64
+	var u uint64
+	limit := uint64(math.MaxInt64)
+	if neg {
+		limit++
+	}
+	for _, r := range whole + frac {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("invalid character %q", r)
+		}
+		if u > (limit-uint64(r-'0'))/10 {
+			return 0, ErrMoneyOverflow
+		}
+		u = u*10 + uint64(r-'0')
+	}
+	if neg {
+		return -int64(u), nil
+	}
+	return int64(u), nil
+}
+
+// Parses a decimal amount like "1234.56" of currency c into a new Money
+// with the decimal places of the currency (see New), or more if the
+// amount has more decimal places. Errors are reported like in
+// NewFromString.
+func parseMoney(amount, c string) (*Money, error) {
+	m := New(0, c)
+	if i := strings.Index(amount, "."); i >= 0 {
+		digits := len(strings.TrimRight(amount[i+1:], "0"))
+		if digits > decimalPlaces(m.scale()) && digits <= MAXDEC {
+			m = NewWithScale(0, c, digits)
+		}
+	}
+	x, err := parseDecimal(amount, decimalPlaces(m.scale()))
+	if err == ErrMoneyDecimalPlacesTooLarge || err == ErrMoneyOverflow {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("i18n: money cannot parse %q: %v", amount, err)
+	}
+	return m.Set(x), nil
+}
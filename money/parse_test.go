@@ -0,0 +1,231 @@
+package money
+
+import (
+	"github.com/hailocab/i18n-go/locale"
+	"testing"
+)
+
+func TestParseLocale(t *testing.T) {
+	var fixtures = []struct {
+		s        string
+		locale   string
+		currency string
+		expected int64
+	}{
+		{"$1,234.56", "en_US", "USD", 123456},
+		{"($1,234.56)", "en_US", "USD", -123456},
+		{"$0.01", "en_US", "USD", 1},
+		{"1234.5", "en_US", "USD", 123450},
+		{"1.234,56 €", "de_DE", "EUR", 123456},
+		{"-1.234,56 €", "de_DE", "EUR", -123456},
+		{"-1.234,56 €", "de_DE", "", -123456},
+		{"€ 12.345.678,90", "de_AT", "EUR", 1234567890},
+		{"-€ 12.345.678,90", "de_AT", "EUR", -1234567890},
+		{"€-12'345'678.90", "de_CH", "EUR", -1234567890},
+		{"fr. 12'345'678.90", "de_CH", "CHF", 1234567890},
+		{"¥1,234,567,890", "ja_JP", "JPY", 1234567890},
+		{"-£12,345,678.90", "en_GB", "GBP", -1234567890},
+		{"R 2 000,00", "en_ZA", "ZAR", 200000},
+		{"12.345.678,90 USD", "de_DE", "USD", 1234567890},
+		{"(1,234.56)", "en_US", "USD", -123456},
+		{"( $1,234.56 )", "en_US", "USD", -123456},
+		{"$0.00", "en_US", "USD", 0},
+		{"($0.00)", "en_US", "USD", 0},
+		{"\u221212.345,90 kr", "sv_SE", "SEK", -1234590},
+		{"Rs. 12,34,567.00", "en_IN", "INR", 123456700},
+		{"$1234567.00", "en_US", "USD", 123456700},
+	}
+
+	for i, f := range fixtures {
+		m, err := ParseLocale(f.s, f.locale, f.currency)
+		if err != nil {
+			t.Errorf("%d. expected no error, got %v", i, err)
+			continue
+		}
+		if m.M != f.expected {
+			t.Errorf("%d. expected money amount to be %v, got %v", i, f.expected, m.M)
+		}
+		if f.currency != "" && m.C != f.currency {
+			t.Errorf("%d. expected currency to be %v, got %v", i, f.currency, m.C)
+		}
+	}
+}
+
+func TestParseLocaleInvalid(t *testing.T) {
+	var fixtures = []struct {
+		s      string
+		locale string
+	}{
+		{"$1,234.56", "xx_XX"},
+		{"", "en_US"},
+		{"$", "en_US"},
+		{"abc", "en_US"},
+		{"$1.234.56", "en_US"},
+		{"$1,234.567", "en_US"},
+		{"$1,234.", "en_US"},
+		{"--1", "en_US"},
+		{"(-1)", "en_US"},
+		{"(1", "en_US"},
+		{"1)", "en_US"},
+		{"$1,2,3", "en_US"},
+		{"$12,34.00", "en_US"},
+		{"$1234,567.00", "en_US"},
+		{"$,123", "en_US"},
+		{"$123,", "en_US"},
+		{"$1,,234", "en_US"},
+		{"$1.23,4", "en_US"},
+		{"$1,234,567,89", "en_US"},
+		{"$92,233,720,368,547,758.08", "en_US"},
+	}
+
+	for i, f := range fixtures {
+		m, err := ParseLocale(f.s, f.locale, "USD")
+		if err == nil {
+			t.Errorf("%d. expected error parsing %q, got %v", i, f.s, m)
+		}
+	}
+}
+
+func TestParseLocaleRoundTrip(t *testing.T) {
+	locales := []string{"de_AT", "de_CH", "de_DE", "en_GB", "en_US", "en_ZA", "fr_FR", "hu_HU", "ja_JP", "sv_SE"}
+	amounts := []int64{0, 1, 12, 123, 1234, 123456, 1234567890, -1, -123456, -1234567890}
+
+	for _, loc := range locales {
+		for _, amount := range amounts {
+			m := New(amount, "EUR")
+			for _, s := range []string{m.Format(loc), m.FormatAccounting(loc)} {
+				got, err := ParseLocale(s, loc, "EUR")
+				if err != nil {
+					t.Errorf("expected no error parsing %q in %s, got %v", s, loc, err)
+					continue
+				}
+				if got.M != amount {
+					t.Errorf("expected %q in %s to parse as %v, got %v", s, loc, amount, got.M)
+				}
+			}
+		}
+	}
+}
+
+func TestParseLocalePatterns(t *testing.T) {
+	var fixtures = []struct {
+		s        string
+		locale   string
+		currency string
+		expected int64
+	}{
+		{"1\u00a0234,56\u00a0€", "fr_FR", "EUR", 123456},
+		{"-1\u00a0234,56 €", "fr_FR", "EUR", -123456},
+		{"1 234,56 EUR", "fr_FR", "EUR", 123456},
+		{"-1 234,56 EUR", "fr_FR", "EUR", -123456},
+		{"(1 234,56 €)", "fr_FR", "EUR", -123456},
+		{"1.234,56 €", "de_DE", "EUR", 123456},
+		{"-1.234,56 $", "de_DE", "USD", -123456},
+		{"US$1,234.56", "en_US", "USD", 123456},
+		{"($1,234.56)", "en_US", "USD", -123456},
+		{"(USD 1,234.56)", "en_US", "USD", -123456},
+		{"\u22121.234,56 kr", "sv_SE", "SEK", -123456},
+	}
+
+	for i, f := range fixtures {
+		m, err := ParseLocale(f.s, f.locale, f.currency)
+		if err != nil {
+			t.Errorf("%d. expected no error, got %v", i, err)
+			continue
+		}
+		if m.M != f.expected || m.C != f.currency {
+			t.Errorf("%d. expected %v %v, got %v", i, f.expected, f.currency, m)
+		}
+		if _, _, ok := matchLayout(f.s, locale.Get(f.locale), f.currency); !ok {
+			t.Errorf("%d. expected %q to match a pattern of %s", i, f.s, f.locale)
+		}
+	}
+
+	// Amounts with trailing symbols or codes round-trip through Format
+	locales := []string{"fr_FR", "de_DE", "sv_SE", "pl_PL", "nl_NL"}
+	currencies := []string{"EUR", "USD", "SEK", "JPY"}
+	amounts := []int64{0, 1, 123456, 1234567890, -1, -123456}
+	for _, loc := range locales {
+		for _, c := range currencies {
+			for _, amount := range amounts {
+				m := New(amount, c)
+				for _, s := range []string{m.Format(loc), m.FormatWithCode(loc), m.FormatAccounting(loc), m.FormatWith(loc, FormatOptions{SymbolMode: SymbolNarrow})} {
+					got, err := ParseLocale(s, loc, c)
+					if err != nil {
+						t.Errorf("expected no error parsing %q in %s, got %v", s, loc, err)
+						continue
+					}
+					if !got.Equals(m) {
+						t.Errorf("expected %q in %s to parse as %v, got %v", s, loc, m, got)
+					}
+					if _, _, ok := matchLayout(s, locale.Get(loc), c); !ok {
+						t.Errorf("expected %q to match a pattern of %s", s, loc)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestNewFromString(t *testing.T) {
+	var fixtures = []struct {
+		s        string
+		expected int64
+	}{
+		{"0", 0},
+		{"0.1", 10},
+		{"0.10", 10},
+		{"1234.56", 123456},
+		{"+1234.56", 123456},
+		{"-1234.56", -123456},
+		{"-0.01", -1},
+		{"007", 700},
+		{"92233720368547758.07", 9223372036854775807},
+		{"-92233720368547758.08", -9223372036854775808},
+	}
+
+	for i, f := range fixtures {
+		m, err := NewFromString(f.s, "EUR")
+		if err != nil {
+			t.Errorf("%d. expected no error, got %v", i, err)
+			continue
+		}
+		if m.M != f.expected {
+			t.Errorf("%d. expected money amount to be %v, got %v", i, f.expected, m.M)
+		}
+		if m.C != "EUR" {
+			t.Errorf("%d. expected currency to be %v, got %v", i, "EUR", m.C)
+		}
+	}
+}
+
+func TestNewFromStringInvalid(t *testing.T) {
+	var fixtures = []struct {
+		s   string
+		err error
+	}{
+		{"1.234", ErrMoneyDecimalPlacesTooLarge},
+		{"92233720368547758.08", ErrMoneyOverflow},
+		{"", nil},
+		{"-", nil},
+		{".5", nil},
+		{"1.", nil},
+		{"1 234.56", nil},
+		{" 1234.56", nil},
+		{"1,234.56", nil},
+		{"1.2.3", nil},
+		{"1e3", nil},
+		{"--1", nil},
+	}
+
+	for i, f := range fixtures {
+		m, err := NewFromString(f.s, "EUR")
+		if err == nil {
+			t.Errorf("%d. expected error parsing %q, got %v", i, f.s, m)
+			continue
+		}
+		if f.err != nil && err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+	}
+}
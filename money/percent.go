@@ -0,0 +1,148 @@
+package money
+
+import (
+	"math/big"
+)
+
+// Returns p percent of m as a new Money, e.g. 8.25 percent of 100.00 is
+// 8.25. p is taken as the shortest decimal that converts to it (see
+// NewFromFloat). The result is rounded with the package-wide rounding
+// mode and has the currency and decimal places of m. m is left unchanged.
+// Percent panics with ErrMoneyOverflow if the result overflows and with
+// ErrMoneyNotFinite if p is NaN or infinite.
+func (m *Money) Percent(p float64) *Money {
+	return m.percent(p, 0)
+}
+
+// Returns m increased by p percent as a new Money, e.g. 100.00 increased
+// by 8.25 percent is 108.25. The result is rounded once, like Percent.
+// m is left unchanged.
+func (m *Money) AddPercent(p float64) *Money {
+	return m.percent(p, 100)
+}
+
+// Returns (base + p) percent of m.
+func (m *Money) percent(p float64, base int64) *Money {
+	x, err := decimalRat(p)
+	if err != nil {
+		panic(err)
+	}
+	x.Add(x, new(big.Rat).SetInt64(base))
+	x.Quo(x, big.NewRat(100, 1))
+	r, err := m.mulRat(x, GetRoundingMode())
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// The maximum number of periods of Grow. The exact result of a rate
+// with many decimal places takes time and memory in the number of
+// periods; 65536 periods are about 179 years of daily compounding.
+const maxPeriods = 1 << 16
+
+// Returns m grown by ratePerPeriod compounded over the given number of
+// periods as a new Money, i.e. m * (1 + ratePerPeriod)^periods, e.g.
+// 1000.00 at 0.05 over 10 periods is 1628.89. The rate is taken as the
+// shortest decimal that converts to it (see NewFromFloat). The result is
+// computed exactly and rounded once with the package-wide rounding mode,
+// and has the currency and decimal places of m. A negative number of
+// periods discounts m. m is left unchanged. Grow panics with the errors
+// of GrowErr.
+func (m *Money) Grow(ratePerPeriod float64, periods int) *Money {
+	r, err := m.GrowErr(ratePerPeriod, periods)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Returns m grown like Grow, but returns ErrMoneyInvalidPeriods if there
+// are more than 65536 periods either way, ErrMoneyNotFinite if the rate
+// is NaN or infinite, ErrMoneyDivideByZero if the rate is -1 and periods
+// is negative, and ErrMoneyOverflow if the result overflows, instead of
+// panicking.
+func (m *Money) GrowErr(ratePerPeriod float64, periods int) (*Money, error) {
+	if periods > maxPeriods || periods < -maxPeriods {
+		return nil, ErrMoneyInvalidPeriods
+	}
+	rate, err := decimalRat(ratePerPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	// (1 + a/b)^n is (a + b)^n / b^n, computed without reducing it
+	num := new(big.Int).Add(rate.Num(), rate.Denom())
+	den := new(big.Int).Set(rate.Denom())
+	if periods < 0 {
+		if num.Sign() == 0 {
+			return nil, ErrMoneyDivideByZero
+		}
+		num, den = den, num
+		periods = -periods
+	}
+	n := big.NewInt(int64(periods))
+	num.Exp(num, n, nil)
+	den.Exp(den, n, nil)
+	if den.Sign() < 0 {
+		num.Neg(num)
+		den.Neg(den)
+	}
+
+	x := GetRoundingMode().roundFrac(num.Mul(num, big.NewInt(m.M)), den)
+	if !x.IsInt64() {
+		return nil, ErrMoneyOverflow
+	}
+	return m.withAmount(x.Int64()), nil
+}
+
+// Splits m, a gross amount including tax at the given rate, into its net
+// amount and the tax, e.g. 120.00 at 0.2 is 100.00 net and 20.00 tax.
+// The net amount is m / (1 + rate) rounded with the package-wide rounding
+// mode and the tax is the remainder, so that net + tax is always exactly
+// m. Both have the currency and decimal places of m. m is left unchanged.
+// ExtractPercent panics with ErrMoneyNotFinite if the rate is NaN or
+// infinite, with ErrMoneyDivideByZero if the rate is -1 and with
+// ErrMoneyOverflow if the net amount or the tax overflows.
+func (m *Money) ExtractPercent(rate float64) (net *Money, tax *Money) {
+	x, err := decimalRat(rate)
+	if err != nil {
+		panic(err)
+	}
+	x.Add(x, big.NewRat(1, 1))
+	if x.Sign() == 0 {
+		panic(ErrMoneyDivideByZero)
+	}
+	net, err = m.mulRat(x.Inv(x), GetRoundingMode())
+	if err != nil {
+		panic(err)
+	}
+	tax, err = m.Copy().SubErr(net)
+	if err != nil {
+		panic(err)
+	}
+	return net, tax
+}
+
+// Adds tax at the given rate to m, a net amount, e.g. 100.00 at 0.2 is
+// 120.00 gross and 20.00 tax. The tax is m * rate rounded with the
+// package-wide rounding mode and the gross amount is m + tax, so that
+// gross - tax is always exactly m. Both have the currency and decimal
+// places of m. m is left unchanged. AddTax panics with ErrMoneyNotFinite
+// if the rate is NaN or infinite and with ErrMoneyOverflow if the result
+// overflows.
+func (m *Money) AddTax(rate float64) (gross *Money, tax *Money) {
+	x, err := decimalRat(rate)
+	if err != nil {
+		panic(err)
+	}
+	tax, err = m.mulRat(x, GetRoundingMode())
+	if err != nil {
+		panic(err)
+	}
+	gross, err = m.Copy().AddErr(tax)
+	if err != nil {
+		panic(err)
+	}
+	return gross, tax
+}
@@ -0,0 +1,220 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPercent(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		p        float64
+		expected int64
+	}{
+		{New(10000, "USD"), 8.25, 825},
+		{New(10000, "USD"), 0, 0},
+		{New(10000, "USD"), 100, 10000},
+		{New(10000, "USD"), 150, 15000},
+		{New(10000, "USD"), -10, -1000},
+		{New(-10000, "USD"), 10, -1000},
+		{New(10, "USD"), 33, 3},
+		{New(10, "USD"), 35, 4},
+		{New(10, "USD"), 45, 5},
+		{New(-10, "USD"), 45, -5},
+		{NewWithScale(1000, "BHD", 3), 12.5, 125},
+	}
+
+	for i, f := range fixtures {
+		got := f.m.Percent(f.p)
+		if got.M != f.expected {
+			t.Errorf("%d. expected money amount to be %v, got %v", i, f.expected, got.M)
+		}
+		if got.C != f.m.C {
+			t.Errorf("%d. expected currency to be %v, got %v", i, f.m.C, got.C)
+		}
+	}
+}
+
+func TestAddPercent(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		p        float64
+		expected int64
+	}{
+		{New(10000, "USD"), 8.25, 10825},
+		{New(10000, "USD"), 0, 10000},
+		{New(10000, "USD"), -10, 9000},
+		{New(-10000, "USD"), 10, -11000},
+		{New(10, "USD"), 33, 13},
+		{New(999, "USD"), 19, 1189},
+	}
+
+	for i, f := range fixtures {
+		m := f.m.M
+		got := f.m.AddPercent(f.p)
+		if got.M != f.expected {
+			t.Errorf("%d. expected money amount to be %v, got %v", i, f.expected, got.M)
+		}
+		if f.m.M != m {
+			t.Errorf("%d. expected receiver to be unchanged, got %v", i, f.m.M)
+		}
+	}
+}
+
+func TestGrow(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		rate     float64
+		periods  int
+		expected int64
+	}{
+		{New(100000, "USD"), 0.05, 0, 100000},
+		{New(100000, "USD"), 0.05, 1, 105000},
+		{New(100000, "USD"), 0.05, 10, 162889},
+		{New(100000, "USD"), 0.01, 12, 112683},
+		{New(100000, "USD"), 0.005, 360, 602258},
+		{New(-100000, "USD"), 0.05, 10, -162889},
+		{New(162889, "USD"), 0.05, -10, 100000},
+		{New(100000, "USD"), -0.1, 2, 81000},
+		{New(100000, "USD"), 0, 100, 100000},
+		{New(100000, "USD"), -1, 3, 0},
+		{New(1000, "JPY"), 0.03, 5, 1159},
+		{New(100000, "USD"), -2, 3, -100000},
+		{New(100000, "USD"), -2, -3, -100000},
+		{New(100000, "USD"), 0.0000001, 65536, 100658},
+	}
+
+	for i, f := range fixtures {
+		orig := *f.m
+		got := f.m.Grow(f.rate, f.periods)
+		if got.M != f.expected || got.C != f.m.C {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, got.M)
+		}
+		if *f.m != orig {
+			t.Errorf("%d. expected m to be unchanged, got %v", i, f.m)
+		}
+	}
+}
+
+func TestGrowPanics(t *testing.T) {
+	var fixtures = []struct {
+		f   func()
+		err error
+	}{
+		{func() { New(100, "USD").Grow(math.NaN(), 1) }, ErrMoneyNotFinite},
+		{func() { New(100, "USD").Grow(math.Inf(1), 1) }, ErrMoneyNotFinite},
+		{func() { New(100, "USD").Grow(-1, -1) }, ErrMoneyDivideByZero},
+		{func() { New(math.MaxInt64/2, "USD").Grow(1, 2) }, ErrMoneyOverflow},
+		{func() { New(100000, "USD").Grow(0.0000001, 200000) }, ErrMoneyInvalidPeriods},
+		{func() { New(100000, "USD").Grow(0.05, -1<<30) }, ErrMoneyInvalidPeriods},
+	}
+
+	for i, f := range fixtures {
+		func() {
+			defer func() {
+				if r := recover(); r != f.err {
+					t.Errorf("%d. expected panic with %v, got %v", i, f.err, r)
+				}
+			}()
+			f.f()
+		}()
+	}
+}
+
+func TestExtractPercent(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		rate     float64
+		net, tax int64
+	}{
+		{New(12000, "USD"), 0.2, 10000, 2000},
+		{New(10000, "USD"), 0.2, 8333, 1667},
+		{New(1, "USD"), 0.2, 1, 0},
+		{New(5, "USD"), 0.2, 4, 1},
+		{New(-10000, "USD"), 0.2, -8333, -1667},
+		{New(10000, "USD"), 0, 10000, 0},
+		{New(10000, "USD"), 0.07, 9346, 654},
+		{New(999, "JPY"), 0.1, 908, 91},
+	}
+
+	for i, f := range fixtures {
+		orig := *f.m
+		net, tax := f.m.ExtractPercent(f.rate)
+		if net.M != f.net || tax.M != f.tax {
+			t.Errorf("%d. expected %v and %v, got %v and %v", i, f.net, f.tax, net.M, tax.M)
+		}
+		if net.C != f.m.C || tax.C != f.m.C {
+			t.Errorf("%d. expected currency %v, got %v and %v", i, f.m.C, net.C, tax.C)
+		}
+		if net.M+tax.M != f.m.M {
+			t.Errorf("%d. expected net + tax to be %v, got %v", i, f.m.M, net.M+tax.M)
+		}
+		if *f.m != orig {
+			t.Errorf("%d. expected m to be unchanged, got %v", i, f.m)
+		}
+	}
+}
+
+func TestExtractPercentInvariant(t *testing.T) {
+	for _, rate := range []float64{0.2, 0.19, 0.175, 0.07, 0.055} {
+		for x := int64(0); x <= 10000; x++ {
+			net, tax := New(x, "USD").ExtractPercent(rate)
+			if net.M+tax.M != x {
+				t.Errorf("%v at %v: expected net + tax to be %v, got %v", x, rate, x, net.M+tax.M)
+			}
+		}
+	}
+}
+
+func TestAddTax(t *testing.T) {
+	var fixtures = []struct {
+		m          *Money
+		rate       float64
+		gross, tax int64
+	}{
+		{New(10000, "USD"), 0.2, 12000, 2000},
+		{New(8333, "USD"), 0.2, 10000, 1667},
+		{New(1, "USD"), 0.2, 1, 0},
+		{New(3, "USD"), 0.2, 4, 1},
+		{New(-10000, "USD"), 0.2, -12000, -2000},
+		{New(9346, "USD"), 0.07, 10000, 654},
+	}
+
+	for i, f := range fixtures {
+		orig := *f.m
+		gross, tax := f.m.AddTax(f.rate)
+		if gross.M != f.gross || tax.M != f.tax {
+			t.Errorf("%d. expected %v and %v, got %v and %v", i, f.gross, f.tax, gross.M, tax.M)
+		}
+		if gross.M-tax.M != f.m.M {
+			t.Errorf("%d. expected gross - tax to be %v, got %v", i, f.m.M, gross.M-tax.M)
+		}
+		if *f.m != orig {
+			t.Errorf("%d. expected m to be unchanged, got %v", i, f.m)
+		}
+	}
+}
+
+func TestTaxPanics(t *testing.T) {
+	var fixtures = []struct {
+		f   func()
+		err error
+	}{
+		{func() { New(100, "USD").ExtractPercent(math.NaN()) }, ErrMoneyNotFinite},
+		{func() { New(100, "USD").ExtractPercent(-1) }, ErrMoneyDivideByZero},
+		{func() { New(8e18, "USD").ExtractPercent(-2.5) }, ErrMoneyOverflow},
+		{func() { New(100, "USD").AddTax(math.Inf(-1)) }, ErrMoneyNotFinite},
+		{func() { New(math.MaxInt64, "USD").AddTax(0.2) }, ErrMoneyOverflow},
+	}
+
+	for i, f := range fixtures {
+		func() {
+			defer func() {
+				if r := recover(); r != f.err {
+					t.Errorf("%d. expected panic with %v, got %v", i, f.err, r)
+				}
+			}()
+			f.f()
+		}()
+	}
+}
@@ -0,0 +1,44 @@
+package money
+
+import (
+	"math/big"
+)
+
+// The number of nano units in a unit, see ToUnitsNanos.
+const nanosPerUnit = 1000000000
+
+// Returns the amount of m in the shape of google.type.Money (see
+// https://github.com/googleapis/googleapis/blob/master/google/type/money.proto)
+// as whole units and nano (10^-9) units along with the currency code,
+// e.g. 12, 340000000, "USD" for 12.34 USD. The nanos have the same sign
+// as the units, e.g. -1, -750000000 for -1.75. Amounts with more than 9
+// decimal places are rounded with the package-wide rounding mode.
+func (m *Money) ToUnitsNanos() (units int64, nanos int32, code string) {
+	places := decimalPlaces(m.scale())
+	if places <= 9 {
+		return m.M / m.scale(), int32(m.M % m.scale() * pow10[9-places]), m.C
+	}
+	x := GetRoundingMode().div(m.M, pow10[places-9])
+	return x / nanosPerUnit, int32(x % nanosPerUnit), m.C
+}
+
+// FromUnitsNanos returns a new Money of an amount in the shape of
+// google.type.Money (see ToUnitsNanos) in the currency code like
+// NewFromMinorUnits, e.g. FromUnitsNanos(12, 340000000, "USD") is 12.34
+// USD. The amount is rounded with the package-wide rounding mode to the
+// decimal digits of the currency, e.g. 12.345 USD to 12.35 USD with
+// HalfUp. The nanos
+// should have the same sign as the units, but are added to them in any
+// case. FromUnitsNanos panics with ErrMoneyOverflow if the amount
+// overflows.
+func FromUnitsNanos(units int64, nanos int32, code string) *Money {
+	m := NewFromMinorUnits(0, code)
+	x := new(big.Int).Mul(big.NewInt(units), big.NewInt(nanosPerUnit))
+	x.Add(x, big.NewInt(int64(nanos)))
+	r := new(big.Rat).SetFrac(x, big.NewInt(nanosPerUnit))
+	v, err := GetRoundingMode().roundRat(r.Mul(r, new(big.Rat).SetInt64(m.scale())))
+	if err != nil {
+		panic(err)
+	}
+	return m.Set(v)
+}
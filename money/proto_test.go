@@ -0,0 +1,73 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestToUnitsNanos(t *testing.T) {
+	var fixtures = []struct {
+		m     *Money
+		units int64
+		nanos int32
+	}{
+		{New(1234, "USD"), 12, 340000000},
+		{New(1200, "USD"), 12, 0},
+		{New(5, "USD"), 0, 50000000},
+		{New(-175, "USD"), -1, -750000000},
+		{New(-5, "USD"), 0, -50000000},
+		{New(1234, "JPY"), 1234, 0},
+		{New(1234, "BHD"), 1, 234000000},
+		{NewWithScale(123456789012, "ETH", 12), 0, 123456789},
+		{NewWithScale(123456789512, "ETH", 12), 0, 123456790},
+		{NewWithScale(-1000000000000500, "ETH", 12), -1000, -1},
+		{New(9223372036854775807, "USD"), 92233720368547758, 70000000},
+	}
+
+	for i, f := range fixtures {
+		units, nanos, code := f.m.ToUnitsNanos()
+		if units != f.units || nanos != f.nanos || code != f.m.C {
+			t.Errorf("%d. expected %v, %v, %v, got %v, %v, %v", i, f.units, f.nanos, f.m.C, units, nanos, code)
+		}
+	}
+}
+
+func TestFromUnitsNanos(t *testing.T) {
+	defer SetRoundingMode(HalfUp)
+
+	var fixtures = []struct {
+		units    int64
+		nanos    int32
+		code     string
+		mode     RoundingMode
+		expected string
+	}{
+		{12, 340000000, "USD", HalfUp, "12.34 USD"},
+		{12, 0, "USD", HalfUp, "12.00 USD"},
+		{-1, -750000000, "USD", HalfUp, "-1.75 USD"},
+		{0, -50000000, "USD", HalfUp, "-0.05 USD"},
+		{12, 345000000, "USD", HalfUp, "12.35 USD"},
+		{12, 345000000, "USD", HalfEven, "12.34 USD"},
+		{-12, -345000000, "USD", HalfUp, "-12.35 USD"},
+		{12, 999999999, "USD", HalfUp, "13.00 USD"},
+		{1234, 500000000, "JPY", HalfUp, "1235 JPY"},
+		{1, 234000000, "BHD", HalfUp, "1.234 BHD"},
+		{12, 340000000, "XYZ", HalfUp, "12.34 XYZ"},
+	}
+
+	for i, f := range fixtures {
+		SetRoundingMode(f.mode)
+		m := FromUnitsNanos(f.units, f.nanos, f.code)
+		if m.String() != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, m)
+		}
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != ErrMoneyOverflow {
+				t.Errorf("expected panic %v, got %v", ErrMoneyOverflow, r)
+			}
+		}()
+		FromUnitsNanos(92233720368547758, 80000000, "USD")
+	}()
+}
@@ -0,0 +1,262 @@
+package money
+
+import (
+	"github.com/hailocab/i18n-go/currency"
+	"math/big"
+)
+
+// RoundingMode specifies how amounts are rounded when an operation yields
+// more precision than can be stored in the minor units of a Money.
+type RoundingMode int
+
+const (
+	// HalfUp rounds to the nearest neighbour, or away from zero if both
+	// neighbours are equidistant.
+	HalfUp RoundingMode = iota
+	// HalfDown rounds to the nearest neighbour, or towards zero if both
+	// neighbours are equidistant.
+	HalfDown
+	// HalfEven rounds to the nearest neighbour, or to the even neighbour
+	// if both neighbours are equidistant (banker's rounding).
+	HalfEven
+	// Ceil rounds towards plus infinity.
+	Ceil
+	// Floor rounds towards minus infinity.
+	Floor
+	// Truncate rounds towards zero.
+	Truncate
+)
+
+// The package-wide rounding mode (see SetRoundingMode).
+var roundingMode = HalfUp
+
+// Returns the package-wide rounding mode.
+func GetRoundingMode() RoundingMode {
+	mu.RLock()
+	defer mu.RUnlock()
+	return roundingMode
+}
+
+// Resets the package-wide rounding mode used by Div and Mulf
+// (default is HalfUp). Like the decimal place, the rounding mode is
+// process-global.
+func SetRoundingMode(mode RoundingMode) {
+	mu.Lock()
+	roundingMode = mode
+	mu.Unlock()
+}
+
+// Returns a/b rounded with the given rounding mode, computed exactly in
+// integer arithmetic. b must not be zero.
+func (mode RoundingMode) div(a, b int64) int64 {
+	q, rem := a/b, a%b
+	if rem == 0 {
+		return q
+	}
+	// The sign of the exact quotient decides the rounding direction
+	away := int64(1)
+	if (rem < 0) != (b < 0) {
+		away = -1
+	}
+	absRem, absB := uint64(rem), uint64(b)
+	if rem < 0 {
+		absRem = uint64(-rem)
+	}
+	if b < 0 {
+		absB = uint64(-b)
+	}
+	// Compare the remainder against half of b without overflowing
+	cmpHalf := 0
+	switch {
+	case absRem > absB-absRem:
+		cmpHalf = 1
+	case absRem < absB-absRem:
+		cmpHalf = -1
+	}
+	switch mode {
+	case HalfUp:
+		if cmpHalf >= 0 {
+			q += away
+		}
+	case HalfDown:
+		if cmpHalf > 0 {
+			q += away
+		}
+	case HalfEven:
+		if cmpHalf > 0 || (cmpHalf == 0 && q%2 != 0) {
+			q += away
+		}
+	case Ceil:
+		if away > 0 {
+			q++
+		}
+	case Floor:
+		if away < 0 {
+			q--
+		}
+	}
+	return q
+}
+
+// Returns x rounded to an integer with the given rounding mode, or
+// ErrMoneyOverflow if the result does not fit into an int64.
+func (mode RoundingMode) roundRat(x *big.Rat) (int64, error) {
+	q := mode.roundBig(x)
+	if !q.IsInt64() {
+		return 0, ErrMoneyOverflow
+	}
+	return q.Int64(), nil
+}
+
+// Returns x rounded to an integer with the given rounding mode.
+func (mode RoundingMode) roundBig(x *big.Rat) *big.Int {
+	return mode.roundFrac(x.Num(), x.Denom())
+}
+
+// Returns num / den rounded to an integer with the given rounding mode.
+// den must be positive. Unlike a big.Rat, the fraction need not be
+// reduced.
+func (mode RoundingMode) roundFrac(num, den *big.Int) *big.Int {
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() != 0 {
+		away := int64(rem.Sign())
+		cmpHalf := new(big.Int).Lsh(new(big.Int).Abs(rem), 1).Cmp(den)
+		var inc bool
+		switch mode {
+		case HalfUp:
+			inc = cmpHalf >= 0
+		case HalfDown:
+			inc = cmpHalf > 0
+		case HalfEven:
+			inc = cmpHalf > 0 || (cmpHalf == 0 && q.Bit(0) != 0)
+		case Ceil:
+			inc = away > 0
+		case Floor:
+			inc = away < 0
+		}
+		if inc {
+			q.Add(q, big.NewInt(away))
+		}
+	}
+	return q
+}
+
+// Returns a new Money with the amount of m rounded with the given
+// rounding mode to the decimal digits of its currency, e.g. to whole
+// units for JPY. The amount keeps the decimal places of m. m is left
+// unchanged, and so is the amount if the currency is unknown or has at
+// least as many decimal digits as m.
+func (m *Money) Round(mode RoundingMode) *Money {
+	r := m.withAmount(m.M)
+	c := currency.Get(m.C)
+	if c == nil {
+		return r
+	}
+	places := decimalPlaces(m.scale())
+	if c.DecimalDigits >= places {
+		return r
+	}
+	f := int64(1)
+	for i := c.DecimalDigits; i < places; i++ {
+		f *= 10
+	}
+	return r.Set(mode.div(m.M, f) * f)
+}
+
+// Returns a new Money with the amount of m rounded to the nearest multiple
+// of increment minor units with the package-wide rounding mode, e.g. to
+// 0.05 with an increment of 5 for cash payments in Swiss francs (1.23
+// becomes 1.25 and 1.22 becomes 1.20). m is left unchanged, and so is
+// the amount if increment is zero or negative. RoundToIncrement panics
+// with ErrMoneyOverflow if the rounded amount overflows.
+func (m *Money) RoundToIncrement(increment int64) *Money {
+	if increment <= 0 {
+		return m.withAmount(m.M)
+	}
+	r, err := mulInt64(GetRoundingMode().div(m.M, increment), increment)
+	if err != nil {
+		panic(err)
+	}
+	return m.withAmount(r)
+}
+
+// Returns a new Money with the amount of m rounded with the package-wide
+// rounding mode to the cash rounding increment of its currency (see
+// currency.Currency.RoundingIncrement), e.g. 1.23 CHF becomes 1.25 CHF and
+// 12.34 SEK becomes 12.00 SEK. The amount keeps the decimal places of m,
+// unless m has fewer than the currency, in which case it gets those of
+// the currency. m is left unchanged, and so is the amount if the
+// currency is unknown or has no rounding increment. RoundCash panics
+// like RoundToIncrement.
+func (m *Money) RoundCash() *Money {
+	c := currency.Get(m.C)
+	if c == nil || c.RoundingIncrement <= 1 {
+		return m.withAmount(m.M)
+	}
+	r := m
+	if decimalPlaces(m.scale()) < c.DecimalDigits {
+		r = m.Rescale(c.DecimalDigits)
+	}
+	// The increment in the decimal places of r
+	increment := int64(c.RoundingIncrement) * (r.scale() / int64(newDecimal(c.DecimalDigits)))
+	return r.RoundToIncrement(increment)
+}
+
+// Returns a new Money with the amount of m truncated towards zero to the
+// given number of decimal places, e.g. 1.239 becomes 1.23 with 2 decimal
+// places and 1.99 becomes 1.00 with none. Unlike Round, the amount is
+// never rounded up. The amount keeps the decimal places of m. m is left
+// unchanged, and so is the amount if digits is at least the decimal
+// places of m. A negative digits is taken as zero.
+func (m *Money) Truncate(digits int) *Money {
+	places := decimalPlaces(m.scale())
+	if digits < 0 {
+		digits = 0
+	}
+	if digits >= places {
+		return m.withAmount(m.M)
+	}
+	f := int64(newDecimal(places - digits))
+	return m.withAmount(m.M / f * f)
+}
+
+// Returns a new Money with the amount of m at the given number of decimal
+// places, e.g. 1.2345 becomes 1.23 with 2 decimal places and 1.23 becomes
+// 1.2300 with 4. The amount is rounded with the package-wide rounding
+// mode if digits is less than the decimal places of m. m is left
+// unchanged. Rescale panics with ErrMoneyDecimalPlacesTooLarge if digits
+// is negative or larger than MAXDEC, and with ErrMoneyOverflow if the
+// amount overflows.
+func (m *Money) Rescale(digits int) *Money {
+	if digits < 0 || digits > MAXDEC {
+		panic(ErrMoneyDecimalPlacesTooLarge)
+	}
+	r := NewWithScale(m.M, m.C, digits)
+	places := decimalPlaces(m.scale())
+	switch {
+	case digits < places:
+		r.M = GetRoundingMode().div(m.M, int64(newDecimal(places-digits)))
+	case digits > places:
+		x, err := mulInt64(m.M, int64(newDecimal(digits-places)))
+		if err != nil {
+			panic(err)
+		}
+		r.M = x
+	}
+	return r
+}
+
+// Returns a new Money with the amount of m at the decimal digits of its
+// currency, independent of the package-wide DP, e.g. 1.2345 USD becomes
+// 1.23 USD and 1234.5 JPY becomes 1235 JPY. Use it after operations that
+// leave more decimal places than the currency has. The amount is rounded
+// with the package-wide rounding mode (see Rescale). m is left unchanged,
+// and so is the amount if the currency is unknown. RoundToCurrency panics
+// with ErrMoneyOverflow if the amount overflows.
+func (m *Money) RoundToCurrency() *Money {
+	c := currency.Get(m.C)
+	if c == nil {
+		return m.withAmount(m.M)
+	}
+	return m.Rescale(c.DecimalDigits)
+}
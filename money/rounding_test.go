@@ -0,0 +1,308 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRoundingModes(t *testing.T) {
+	var fixtures = []struct {
+		a, b     int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{5, 10, HalfUp, 1},
+		{15, 10, HalfUp, 2},
+		{-5, 10, HalfUp, -1},
+		{14, 10, HalfUp, 1},
+		{5, 10, HalfDown, 0},
+		{15, 10, HalfDown, 1},
+		{16, 10, HalfDown, 2},
+		{-5, 10, HalfDown, 0},
+		{5, 10, HalfEven, 0},
+		{15, 10, HalfEven, 2},
+		{25, 10, HalfEven, 2},
+		{-5, 10, HalfEven, 0},
+		{-15, 10, HalfEven, -2},
+		{26, 10, HalfEven, 3},
+		{11, 10, Ceil, 2},
+		{-11, 10, Ceil, -1},
+		{19, 10, Floor, 1},
+		{-11, 10, Floor, -2},
+		{19, 10, Truncate, 1},
+		{-19, 10, Truncate, -1},
+		{19, -10, Truncate, -1},
+		{15, -10, HalfUp, -2},
+		{20, 10, Floor, 2},
+		{9223372036854775807, 2, HalfUp, 4611686018427387904},
+		{-9223372036854775808, 3, HalfUp, -3074457345618258603},
+	}
+
+	for i, f := range fixtures {
+		if got := f.mode.div(f.a, f.b); got != f.expected {
+			t.Errorf("%d. expected %d/%d to round to %d, got %d", i, f.a, f.b, f.expected, got)
+		}
+	}
+}
+
+func TestRound(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		mode     RoundingMode
+		expected int64
+	}{
+		{NewWithScale(50, "JPY", 2), HalfEven, 0},
+		{NewWithScale(150, "JPY", 2), HalfEven, 200},
+		{NewWithScale(250, "JPY", 2), HalfEven, 200},
+		{NewWithScale(-150, "JPY", 2), HalfEven, -200},
+		{NewWithScale(50, "JPY", 2), HalfUp, 100},
+		{NewWithScale(50, "JPY", 2), HalfDown, 0},
+		{NewWithScale(101, "JPY", 2), Ceil, 200},
+		{NewWithScale(199, "JPY", 2), Floor, 100},
+		{NewWithScale(199, "JPY", 2), Truncate, 100},
+		{New(123, "USD"), HalfEven, 123},
+		{New(123, "XYZ"), HalfEven, 123},
+	}
+
+	for i, f := range fixtures {
+		got := f.m.Round(f.mode)
+		if got.M != f.expected {
+			t.Errorf("%d. expected money amount to be %v, got %v", i, f.expected, got.M)
+		}
+		if got.C != f.m.C {
+			t.Errorf("%d. expected currency to be %v, got %v", i, f.m.C, got.C)
+		}
+	}
+}
+
+func TestSetRoundingMode(t *testing.T) {
+	defer SetRoundingMode(HalfUp)
+
+	// 0.05 / 0.10 = 0.5
+	if got := New(5, "EUR").Div(New(10, "EUR")); got.M != 50 {
+		t.Errorf("expected money amount to be %v, got %v", 50, got.M)
+	}
+	// 0.01 / 2.00 = 0.005
+	if got := New(1, "EUR").Div(New(200, "EUR")); got.M != 1 {
+		t.Errorf("expected money amount to be %v, got %v", 1, got.M)
+	}
+	SetRoundingMode(HalfEven)
+	if got := New(1, "EUR").Div(New(200, "EUR")); got.M != 0 {
+		t.Errorf("expected money amount to be %v, got %v", 0, got.M)
+	}
+	SetRoundingMode(Ceil)
+	if got := New(1, "EUR").Div(New(300, "EUR")); got.M != 1 {
+		t.Errorf("expected money amount to be %v, got %v", 1, got.M)
+	}
+}
+
+func TestRoundCash(t *testing.T) {
+	defer SetRoundingMode(HalfUp)
+
+	var fixtures = []struct {
+		m        *Money
+		mode     RoundingMode
+		expected string
+	}{
+		{New(123, "CHF"), HalfUp, "1.25 CHF"},
+		{New(122, "CHF"), HalfUp, "1.20 CHF"},
+		{New(-123, "CHF"), HalfUp, "-1.25 CHF"},
+		{New(1250, "SEK"), HalfUp, "13.00 SEK"},
+		{New(1250, "SEK"), HalfEven, "12.00 SEK"},
+		{New(1201, "DKK"), Floor, "12.00 DKK"},
+		{New(1226, "DKK"), HalfUp, "12.50 DKK"},
+		{New(123, "USD"), HalfUp, "1.23 USD"},
+		{New(123, "JPY"), HalfUp, "123 JPY"},
+		{New(123, "XYZ"), HalfUp, "1.23 XYZ"},
+		{NewWithScale(12345, "CHF", 4), HalfUp, "1.2500 CHF"},
+		{NewWithScale(12, "CHF", 1), HalfUp, "1.20 CHF"},
+	}
+
+	for i, f := range fixtures {
+		SetRoundingMode(f.mode)
+		m := f.m.Copy()
+		got := f.m.RoundCash()
+		if got.String() != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, got)
+		}
+		if got == f.m || !f.m.Equals(m) {
+			t.Errorf("%d. expected a new Money and %v to be unchanged, got %v", i, m, f.m)
+		}
+	}
+}
+
+func TestRoundToIncrement(t *testing.T) {
+	defer SetRoundingMode(HalfUp)
+
+	var fixtures = []struct {
+		m         *Money
+		increment int64
+		mode      RoundingMode
+		expected  int64
+	}{
+		{New(123, "CHF"), 5, HalfUp, 125},
+		{New(122, "CHF"), 5, HalfUp, 120},
+		{New(125, "CHF"), 5, HalfUp, 125},
+		{New(-123, "CHF"), 5, HalfUp, -125},
+		{New(-122, "CHF"), 5, HalfUp, -120},
+		{New(1234, "SEK"), 100, HalfUp, 1200},
+		{New(1250, "SEK"), 100, HalfUp, 1300},
+		{New(1250, "SEK"), 100, HalfEven, 1200},
+		{New(1350, "SEK"), 100, HalfEven, 1400},
+		{New(1201, "SEK"), 100, Ceil, 1300},
+		{New(1299, "SEK"), 100, Floor, 1200},
+		{New(123, "CHF"), 1, HalfUp, 123},
+		{New(123, "CHF"), 0, HalfUp, 123},
+		{New(123, "CHF"), -5, HalfUp, 123},
+	}
+
+	for i, f := range fixtures {
+		SetRoundingMode(f.mode)
+		got := f.m.RoundToIncrement(f.increment)
+		if got.M != f.expected {
+			t.Errorf("%d. expected money amount to be %v, got %v", i, f.expected, got.M)
+		}
+		if got == f.m {
+			t.Errorf("%d. expected a new Money", i)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		digits   int
+		expected int64
+	}{
+		{NewWithScale(1239, "USD", 3), 2, 1230},
+		{NewWithScale(-1239, "USD", 3), 2, -1230},
+		{New(150, "USD"), 0, 100},
+		{New(-150, "USD"), 0, -100},
+		{New(199, "USD"), 0, 100},
+		{New(199, "USD"), -1, 100},
+		{New(199, "USD"), 2, 199},
+		{New(199, "USD"), 5, 199},
+		{New(1999, "JPY"), 0, 1999},
+	}
+
+	for i, f := range fixtures {
+		got := f.m.Truncate(f.digits)
+		if got.M != f.expected || got.C != f.m.C {
+			t.Errorf("%d. expected money amount to be %v, got %v", i, f.expected, got.M)
+		}
+		if got == f.m {
+			t.Errorf("%d. expected a new Money", i)
+		}
+	}
+}
+
+func TestTruncateVersusRound(t *testing.T) {
+	var fixtures = []struct {
+		m         *Money
+		truncated int64
+		rounded   int64
+	}{
+		{NewWithScale(1235, "USD", 3), 1230, 1240},
+		{NewWithScale(-1235, "USD", 3), -1230, -1240},
+		{NewWithScale(1234, "USD", 3), 1230, 1230},
+		{NewWithScale(1239, "USD", 3), 1230, 1240},
+	}
+
+	for i, f := range fixtures {
+		if got := f.m.Truncate(2).M; got != f.truncated {
+			t.Errorf("%d. expected truncated amount to be %v, got %v", i, f.truncated, got)
+		}
+		if got := f.m.Round(HalfUp).M; got != f.rounded {
+			t.Errorf("%d. expected rounded amount to be %v, got %v", i, f.rounded, got)
+		}
+	}
+}
+
+func TestRescale(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		digits   int
+		expected string
+	}{
+		{New(123, "USD"), 4, "1.2300 USD"},
+		{NewWithScale(12345, "USD", 4), 2, "1.23 USD"},
+		{NewWithScale(12350, "USD", 4), 2, "1.24 USD"},
+		{NewWithScale(-12350, "USD", 4), 2, "-1.24 USD"},
+		{NewWithScale(12349, "USD", 4), 2, "1.23 USD"},
+		{New(150, "USD"), 0, "2 USD"},
+		{New(1000, "JPY"), 2, "1000.00 JPY"},
+		{New(123, "USD"), 2, "1.23 USD"},
+	}
+
+	for i, f := range fixtures {
+		orig := *f.m
+		got := f.m.Rescale(f.digits)
+		if got.String() != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, got)
+		}
+		if *f.m != orig {
+			t.Errorf("%d. expected receiver to be unchanged, got %v", i, f.m)
+		}
+		if back := got.Rescale(decimalPlaces(f.m.scale())); f.digits > decimalPlaces(f.m.scale()) && !back.Equals(f.m) {
+			t.Errorf("%d. expected %v after scaling back, got %v", i, f.m, back)
+		}
+	}
+}
+
+func TestRescalePanics(t *testing.T) {
+	var fixtures = []struct {
+		f   func()
+		err error
+	}{
+		{func() { New(123, "USD").Rescale(-1) }, ErrMoneyDecimalPlacesTooLarge},
+		{func() { New(123, "USD").Rescale(MAXDEC + 1) }, ErrMoneyDecimalPlacesTooLarge},
+		{func() { New(math.MaxInt64, "USD").Rescale(3) }, ErrMoneyOverflow},
+		{func() { New(math.MaxInt64, "USD").RoundToIncrement(10) }, ErrMoneyOverflow},
+		{func() { NewWithScale(math.MaxInt64, "CHF", 4).RoundCash() }, ErrMoneyOverflow},
+	}
+
+	for i, f := range fixtures {
+		func() {
+			defer func() {
+				if r := recover(); r != f.err {
+					t.Errorf("%d. expected panic with %v, got %v", i, f.err, r)
+				}
+			}()
+			f.f()
+		}()
+	}
+}
+
+func TestRoundToCurrency(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		expected string
+	}{
+		{NewWithScale(12345, "USD", 4), "1.23 USD"},
+		{NewWithScale(12350, "USD", 4), "1.24 USD"},
+		{NewWithScale(-12350, "USD", 4), "-1.24 USD"},
+		{New(123, "USD"), "1.23 USD"},
+		{NewWithScale(12345, "JPY", 1), "1235 JPY"},
+		{NewWithScale(12344, "JPY", 1), "1234 JPY"},
+		{NewWithScale(123456, "JPY", 2), "1235 JPY"},
+		{NewWithScale(1234, "BHD", 2), "12.340 BHD"},
+		{NewWithScale(12345, "XYZ", 4), "1.2345 XYZ"},
+	}
+
+	for i, f := range fixtures {
+		orig := *f.m
+		got := f.m.RoundToCurrency()
+		if got.String() != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, got)
+		}
+		if *f.m != orig {
+			t.Errorf("%d. expected receiver to be unchanged, got %v", i, f.m)
+		}
+	}
+
+	// After Div, which keeps the decimal places of m
+	m := NewWithScale(100000, "USD", 4).Div(New(300, "USD"))
+	if got := m.RoundToCurrency(); got.String() != "3.33 USD" || !got.Equals(New(333, "USD")) {
+		t.Errorf("expected %v, got %v", "3.33 USD", got)
+	}
+}
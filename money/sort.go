@@ -0,0 +1,21 @@
+package money
+
+// MoneySlice attaches the methods of sort.Interface to []*Money. Items are
+// ordered by currency code first and by amount within each currency, so
+// a slice with mixed currencies still sorts deterministically.
+type MoneySlice []*Money
+
+func (s MoneySlice) Len() int {
+	return len(s)
+}
+
+func (s MoneySlice) Less(i, j int) bool {
+	if s[i].C != s[j].C {
+		return s[i].C < s[j].C
+	}
+	return s[i].LessThan(s[j])
+}
+
+func (s MoneySlice) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
@@ -0,0 +1,44 @@
+package money
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestMoneySlice(t *testing.T) {
+	var fixtures = []struct {
+		items    []*Money
+		expected []*Money
+	}{
+		{
+			[]*Money{},
+			[]*Money{},
+		},
+		{
+			[]*Money{New(0, "USD"), New(-250, "USD"), New(100, "USD"), New(-1, "USD"), New(9999, "USD")},
+			[]*Money{New(-250, "USD"), New(-1, "USD"), New(0, "USD"), New(100, "USD"), New(9999, "USD")},
+		},
+		{
+			[]*Money{New(100, "USD"), New(-5, "EUR"), New(-100, "USD"), New(0, "EUR")},
+			[]*Money{New(-5, "EUR"), New(0, "EUR"), New(-100, "USD"), New(100, "USD")},
+		},
+		{
+			[]*Money{NewWithScale(1000, "USD", 3), New(99, "USD"), NewWithScale(-1, "USD", 3)},
+			[]*Money{NewWithScale(-1, "USD", 3), New(99, "USD"), NewWithScale(1000, "USD", 3)},
+		},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i, f := range fixtures {
+		items := make(MoneySlice, len(f.items))
+		copy(items, f.items)
+		r.Shuffle(len(items), items.Swap)
+		sort.Sort(items)
+		for j := range items {
+			if !items[j].Equals(f.expected[j]) {
+				t.Errorf("%d. expected item %d to be %v, got %v", i, j, f.expected[j], items[j])
+			}
+		}
+	}
+}
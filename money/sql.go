@@ -0,0 +1,71 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// SQLMoney wraps Money to implement the sql.Scanner and driver.Valuer
+// interfaces (Money itself can not implement driver.Valuer because of
+// its Value method).
+//
+// Only the amount is stored, e.g. in a NUMERIC column, as SQL numbers
+// carry no currency. The currency is kept in the wrapped Money: set C
+// before scanning (e.g. from a sibling column) and Scan leaves it as is.
+type SQLMoney struct {
+	Money
+}
+
+// Implements driver.Valuer. The amount is returned as a decimal string
+// like "1234.56" with the decimal digits of the currency.
+func (s SQLMoney) Value() (driver.Value, error) {
+	return formatDecimal(s.M, s.scale(), s.serializedDigits()), nil
+}
+
+// Implements sql.Scanner. Decimal strings (as []byte or string), int64
+// and float64 values are accepted and interpreted as major units, e.g.
+// "1234.56" yields M=123456 with 2 decimal places. Strings in accounting
+// style like "(1,234.56)" are accepted as well.
+func (s *SQLMoney) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return s.scanString(string(v))
+	case string:
+		return s.scanString(v)
+	case int64:
+		r, err := mulInt64(v, s.scale())
+		if err != nil {
+			return err
+		}
+		s.M = r
+		return nil
+	case float64:
+		_, err := s.SetfErr(v)
+		return err
+	}
+	return fmt.Errorf("i18n: money cannot scan %T", src)
+}
+
+// Scans a decimal string like "1234.56". As spreadsheets and reports
+// often hold amounts in accounting style, the string may also be grouped
+// with commas and wrapped in parentheses if negative, e.g. "(1,234.56)".
+func (s *SQLMoney) scanString(str string) error {
+	str, neg := trimParentheses(strings.TrimSpace(str))
+	parts := strings.SplitN(str, ".", 2)
+	whole, ok := ungroup(parts[0], ",", []int{3})
+	if !ok {
+		return fmt.Errorf("i18n: money cannot scan %q: misplaced group separator", str)
+	}
+	parts[0] = whole
+	str = strings.Join(parts, ".")
+	if neg {
+		str = "-" + str
+	}
+	m, err := parseDecimal(str, decimalPlaces(s.scale()))
+	if err != nil {
+		return err
+	}
+	s.M = m
+	return nil
+}
@@ -0,0 +1,85 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSQLMoneyValue(t *testing.T) {
+	var fixtures = []struct {
+		m        Money
+		expected string
+	}{
+		{Money{M: 0, C: "USD"}, "0.00"},
+		{Money{M: 123456, C: "USD"}, "1234.56"},
+		{Money{M: -123456, C: "USD"}, "-1234.56"},
+		{Money{M: 123400, C: "JPY"}, "1234"},
+	}
+
+	for i, f := range fixtures {
+		v, err := SQLMoney{f.m}.Value()
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if v != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, v)
+		}
+	}
+}
+
+func TestSQLMoneyScan(t *testing.T) {
+	var fixtures = []struct {
+		src      interface{}
+		expected int64
+	}{
+		{[]byte("1234.56"), 123456},
+		{"1234.56", 123456},
+		{"-0.5", -50},
+		{"1234.5600", 123456},
+		{"(1,234.56)", -123456},
+		{" ( 1234.56 ) ", -123456},
+		{"1,234,567.89", 123456789},
+		{"0.00", 0},
+		{int64(1234), 123400},
+		{float64(1234.56), 123456},
+		{float64(-0.01), -1},
+	}
+
+	for i, f := range fixtures {
+		s := SQLMoney{Money{M: 0, C: "USD"}}
+		if err := s.Scan(f.src); err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if s.M != f.expected {
+			t.Errorf("%d. expected money amount to be %v, got %v", i, f.expected, s.M)
+		}
+		if s.C != "USD" {
+			t.Errorf("%d. expected currency to be %v, got %v", i, "USD", s.C)
+		}
+	}
+}
+
+func TestSQLMoneyScanInvalid(t *testing.T) {
+	var fixtures = []interface{}{
+		nil,
+		true,
+		"abc",
+		"1234.567",
+		"(-1.00)",
+		"(1.00",
+		"1,2,3",
+		"12,34.00",
+		"1.23,4",
+		int64(9223372036854775807),
+		math.NaN(),
+		math.Inf(1),
+		float64(1e19),
+	}
+
+	for i, src := range fixtures {
+		var s SQLMoney
+		if err := s.Scan(src); err == nil {
+			t.Errorf("%d. expected error scanning %v, got %v", i, src, s.M)
+		}
+	}
+}
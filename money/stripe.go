@@ -0,0 +1,89 @@
+package money
+
+import (
+	"strings"
+
+	"github.com/hailocab/i18n-go/currency"
+)
+
+// The decimal places of Stripe amounts that differ from those of the
+// currency data, see https://stripe.com/docs/currencies#special-cases.
+// Stripe takes zero-decimal currencies in whole units, and ISK and UGX
+// with two decimal places for backwards compatibility, even though they
+// have no minor unit.
+var stripeDigits = map[string]int{
+	"BIF": 0,
+	"CLP": 0,
+	"DJF": 0,
+	"GNF": 0,
+	"JPY": 0,
+	"KMF": 0,
+	"KRW": 0,
+	"MGA": 0,
+	"PYG": 0,
+	"RWF": 0,
+	"VND": 0,
+	"VUV": 0,
+	"XAF": 0,
+	"XOF": 0,
+	"XPF": 0,
+	"ISK": 2,
+	"UGX": 2,
+}
+
+// The three-decimal currencies, whose Stripe amounts must be multiples of
+// 10, i.e. have 0 as their last digit.
+var stripeRoundedToTen = map[string]bool{
+	"BHD": true,
+	"JOD": true,
+	"KWD": true,
+	"OMR": true,
+	"TND": true,
+}
+
+// Returns the amount of m as an integer in the smallest unit Stripe uses
+// for its currency, along with the lower case currency code, e.g. 1234,
+// "usd" for 12.34 USD. Zero-decimal currencies like JPY are passed in
+// whole units, e.g. 1234, "jpy" for 1234 JPY, and ISK and UGX with two
+// decimal places. Three-decimal currencies like BHD are passed in minor
+// units, but Stripe requires them to be multiples of 10, so 1.234 BHD is
+// rounded to 1230. Amounts are rounded with the package-wide rounding
+// mode if needed. Unknown currencies use the decimal places of m.
+// ToStripeAmount panics with ErrMoneyOverflow if the amount overflows.
+func (m *Money) ToStripeAmount() (int64, string) {
+	code := strings.ToUpper(m.C)
+	digits, ok := stripeDigits[code]
+	if !ok {
+		digits = decimalPlaces(m.scale())
+		if c := currency.Get(code); c != nil {
+			digits = c.DecimalDigits
+		}
+	}
+	r := m.Rescale(digits)
+	if stripeRoundedToTen[code] {
+		r = r.RoundToIncrement(10)
+	}
+	return r.M, strings.ToLower(m.C)
+}
+
+// FromStripeAmount returns a new Money of an amount in the smallest unit
+// Stripe uses for the currency code, which may be in any case, like New
+// (see ToStripeAmount), e.g. 1234, "jpy" is 1234 JPY and 12300, "isk" is
+// 123 ISK. The amount has the decimal digits of the currency, rounded
+// with the package-wide rounding mode if needed. Returns
+// ErrMoneyUnknownCurrency if the currency is neither known nor a
+// currency with special rules at Stripe.
+func FromStripeAmount(amount int64, code string) (*Money, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	c := currency.Get(code)
+	digits, ok := stripeDigits[code]
+	switch {
+	case c == nil && !ok:
+		return nil, ErrMoneyUnknownCurrency
+	case c == nil:
+		return NewWithScale(amount, code, digits), nil
+	case !ok:
+		return NewFromMinorUnits(amount, c.Code), nil
+	}
+	return NewWithScale(amount, c.Code, digits).Rescale(c.DecimalDigits), nil
+}
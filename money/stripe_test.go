@@ -0,0 +1,72 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestToStripeAmount(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		amount   int64
+		currency string
+	}{
+		{New(1234, "USD"), 1234, "usd"},
+		{New(-1234, "EUR"), -1234, "eur"},
+		{NewWithScale(12345, "USD", 3), 1235, "usd"},
+		// Zero-decimal currencies are passed in whole units
+		{New(1234, "JPY"), 1234, "jpy"},
+		{New(123400, "CLP"), 1234, "clp"},
+		{New(123450, "CLP"), 1235, "clp"},
+		{NewWithScale(1234, "BIF", 0), 1234, "bif"},
+		// ISK is passed with two decimal places
+		{New(123, "ISK"), 12300, "isk"},
+		// Three-decimal currencies are multiples of 10
+		{New(1230, "BHD"), 1230, "bhd"},
+		{New(1234, "BHD"), 1230, "bhd"},
+		{New(1235, "KWD"), 1240, "kwd"},
+		{New(1234, "XYZ"), 1234, "xyz"},
+	}
+
+	for i, f := range fixtures {
+		amount, currency := f.m.ToStripeAmount()
+		if amount != f.amount || currency != f.currency {
+			t.Errorf("%d. expected %v %v, got %v %v", i, f.amount, f.currency, amount, currency)
+		}
+	}
+}
+
+func TestFromStripeAmount(t *testing.T) {
+	var fixtures = []struct {
+		amount   int64
+		currency string
+		expected string
+		err      error
+	}{
+		{1234, "usd", "12.34 USD", nil},
+		{-1234, "EUR", "-12.34 EUR", nil},
+		{1234, "jpy", "1234 JPY", nil},
+		{1234, "clp", "1234.00 CLP", nil},
+		{1234, "bif", "1234 BIF", nil},
+		{12300, "isk", "123 ISK", nil},
+		{1230, "bhd", "1.230 BHD", nil},
+		{1234, "xyz", "", ErrMoneyUnknownCurrency},
+	}
+
+	for i, f := range fixtures {
+		m, err := FromStripeAmount(f.amount, f.currency)
+		if err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if m.String() != f.expected {
+			t.Errorf("%d. expected %v, got %v", i, f.expected, m)
+		}
+		// The amount round-trips
+		if amount, _ := m.ToStripeAmount(); amount != f.amount {
+			t.Errorf("%d. expected Stripe amount %v, got %v", i, f.amount, amount)
+		}
+	}
+}
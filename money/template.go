@@ -0,0 +1,23 @@
+package money
+
+import (
+	"text/template"
+)
+
+// Returns template functions for formatting Money in templates:
+//
+//	money     formats Money with the given default locale (see Format)
+//	moneyLoc  formats Money with the locale given as first argument
+//
+// e.g. {{money .Price}} or {{.Price | moneyLoc "de_DE"}}. For use with
+// html/template, convert the result to html/template.FuncMap.
+func TemplateFuncs(defaultLocale string) template.FuncMap {
+	return template.FuncMap{
+		"money": func(m *Money) string {
+			return m.Format(defaultLocale)
+		},
+		"moneyLoc": func(loc string, m *Money) string {
+			return m.Format(loc)
+		},
+	}
+}
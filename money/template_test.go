@@ -0,0 +1,57 @@
+package money
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"os"
+	"testing"
+	"text/template"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	var fixtures = []struct {
+		text     string
+		expected string
+	}{
+		{`{{money .}}`, "$1,234.56"},
+		{`{{moneyLoc "de_DE" .}}`, "1.234,56 $"},
+		{`{{. | moneyLoc "en_GB"}}`, "$1,234.56"},
+		{`{{moneyLoc "xx_XX" .}}`, "1234.56 USD"},
+	}
+
+	m := New(123456, "USD")
+	for i, f := range fixtures {
+		tmpl, err := template.New("test").Funcs(TemplateFuncs("en_US")).Parse(f.text)
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, m); err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if got := buf.String(); got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+	}
+}
+
+func TestTemplateFuncsHTML(t *testing.T) {
+	tmpl, err := htmltemplate.New("test").Funcs(htmltemplate.FuncMap(TemplateFuncs("de_DE"))).Parse(`<b>{{money .}}</b>`)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, New(-123456, "EUR")); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := buf.String(); got != "<b>-1.234,56 €</b>" {
+		t.Errorf("expected %q, got %q", "<b>-1.234,56 €</b>", got)
+	}
+}
+
+func ExampleTemplateFuncs() {
+	tmpl := template.Must(template.New("invoice").Funcs(TemplateFuncs("en_US")).Parse(
+		"Total: {{money .}} ({{moneyLoc \"de_DE\" .}})\n"))
+	tmpl.Execute(os.Stdout, New(4999, "EUR"))
+	// Output: Total: €49.99 (49,99 €)
+}
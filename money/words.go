@@ -0,0 +1,101 @@
+package money
+
+import (
+	"fmt"
+	"github.com/hailocab/i18n-go/currency"
+	"strings"
+)
+
+var (
+	englishOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen",
+	}
+	englishTens = []string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+	}
+	englishScales = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+	}
+)
+
+// Spells out m in words in the language lang, e.g. "One thousand two
+// hundred thirty-four US dollars and 56 cents" for 1234.56 USD in
+// English. The units are named after the currency (see currency.Currency).
+// The minor units are given in digits, as is customary on cheques, and
+// omitted if they are zero. If the name of the minor unit is not known,
+// they are given as a fraction, e.g. "and 56/100". Only English ("en" or
+// an English locale such as "en_GB") is supported, ToWords returns
+// ErrMoneyUnsupportedLanguage for other languages.
+func (m *Money) ToWords(lang string) (string, error) {
+	if l := strings.ToLower(lang); l != "en" && !strings.HasPrefix(l, "en_") && !strings.HasPrefix(l, "en-") {
+		return "", ErrMoneyUnsupportedLanguage
+	}
+
+	abs := uint64(m.M)
+	if m.M < 0 {
+		abs = uint64(-(m.M + 1)) + 1
+	}
+	dp := uint64(m.scale())
+	major, minor := abs/dp, abs%dp
+
+	c := currency.Get(m.C)
+	unit := m.C
+	if c != nil {
+		unit = c.UnitName(int64(major))
+	}
+
+	s := englishWords(major)
+	if unit != "" {
+		s += " " + unit
+	}
+	if minor > 0 {
+		if c != nil && c.MinorUnitName != "" {
+			s += fmt.Sprintf(" and %d %s", minor, c.MinorUnitNameFor(int64(minor)))
+		} else {
+			s += fmt.Sprintf(" and %d/%d", minor, dp)
+		}
+	}
+	if m.M < 0 {
+		s = "minus " + s
+	}
+	return strings.ToUpper(s[:1]) + s[1:], nil
+}
+
+// Returns n spelled out in English, e.g. "one hundred twenty-three".
+func englishWords(n uint64) string {
+	if n == 0 {
+		return englishOnes[0]
+	}
+	var groups []string
+	for scale := 0; n > 0; scale++ {
+		if g := n % 1000; g > 0 {
+			words := englishHundreds(g)
+			if englishScales[scale] != "" {
+				words += " " + englishScales[scale]
+			}
+			groups = append([]string{words}, groups...)
+		}
+		n /= 1000
+	}
+	return strings.Join(groups, " ")
+}
+
+// Returns n < 1000 spelled out in English.
+func englishHundreds(n uint64) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, englishOnes[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n >= 20 && n%10 > 0:
+		words = append(words, englishTens[n/10]+"-"+englishOnes[n%10])
+	case n >= 20:
+		words = append(words, englishTens[n/10])
+	case n > 0:
+		words = append(words, englishOnes[n])
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,60 @@
+package money
+
+import (
+	"math"
+	"testing"
+)
+
+func TestToWords(t *testing.T) {
+	var fixtures = []struct {
+		m        *Money
+		expected string
+	}{
+		{New(0, "USD"), "Zero US dollars"},
+		{New(100, "USD"), "One US dollar"},
+		{New(10000, "USD"), "One hundred US dollars"},
+		{New(123456, "USD"), "One thousand two hundred thirty-four US dollars and 56 cents"},
+		{New(100000000, "USD"), "One million US dollars"},
+		{New(-10001, "USD"), "Minus one hundred US dollars and 1 cent"},
+		{New(-5, "EUR"), "Minus zero euros and 5 cents"},
+		{New(2000015, "GBP"), "Twenty thousand British pounds and 15 pence"},
+		{New(1000000, "JPY"), "One million Japanese yen"},
+		{New(1, "JPY"), "One Japanese yen"},
+		{New(1234500, "BHD"), "One thousand two hundred thirty-four Bahraini dinars and 500 fils"},
+		{New(1011, "XYZ"), "Ten XYZ and 11/100"},
+		{NewWithScale(1011, "JPY", 2), "Ten Japanese yen and 11 sen"},
+		{New(11900000, "USD"), "One hundred nineteen thousand US dollars"},
+		{New(math.MinInt64, "JPY"), "Minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight Japanese yen"},
+	}
+
+	for i, f := range fixtures {
+		got, err := f.m.ToWords("en")
+		if err != nil {
+			t.Fatalf("%d. expected no error, got %v", i, err)
+		}
+		if got != f.expected {
+			t.Errorf("%d. expected %q, got %q", i, f.expected, got)
+		}
+	}
+}
+
+func TestToWordsLanguage(t *testing.T) {
+	var fixtures = []struct {
+		lang string
+		err  error
+	}{
+		{"en", nil},
+		{"EN", nil},
+		{"en_GB", nil},
+		{"en-US", nil},
+		{"de", ErrMoneyUnsupportedLanguage},
+		{"eng", ErrMoneyUnsupportedLanguage},
+		{"", ErrMoneyUnsupportedLanguage},
+	}
+
+	for i, f := range fixtures {
+		if _, err := New(100, "USD").ToWords(f.lang); err != f.err {
+			t.Errorf("%d. expected error %v, got %v", i, f.err, err)
+		}
+	}
+}